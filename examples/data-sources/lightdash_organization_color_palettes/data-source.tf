@@ -0,0 +1,3 @@
+data "lightdash_organization_color_palettes" "all" {
+  organization_uuid = "xxxxx-xxxxxx-xxxx"
+}
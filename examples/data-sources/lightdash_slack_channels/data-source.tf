@@ -0,0 +1,9 @@
+data "lightdash_slack_channels" "data" {
+  organization_uuid = "xxxxx-xxxxxx-xxxx"
+  search            = "data"
+}
+
+# Look channels up by name instead of hard-coding their IDs.
+output "data_alerts_channel_id" {
+  value = data.lightdash_slack_channels.data.channel_ids_by_name["data-alerts"]
+}
@@ -3,3 +3,9 @@ data "lightdash_space" "test" {
   project_uuid      = "yyyyy-yyyy-yyyy"
   space_uuid        = "zzzzz-zzzz-zzzz"
 }
+
+data "lightdash_space" "by_path" {
+  organization_uuid = "xxxx-xxxx-xxxx"
+  project_uuid      = "yyyyy-yyyy-yyyy"
+  path              = "Marketing/Campaigns/2026"
+}
@@ -2,3 +2,27 @@ data "lightdash_spaces" "test" {
   organization_uuid = "xxxx-xxxx-xxxx"
   project_uuid      = "yyyyy-yyyy-yyyy"
 }
+
+# All public root spaces, keyed by name
+data "lightdash_spaces" "public_roots" {
+  organization_uuid = "xxxx-xxxx-xxxx"
+  project_uuid      = "yyyyy-yyyy-yyyy"
+  root_only         = true
+  is_private        = false
+}
+
+output "public_root_spaces" {
+  value = { for space in data.lightdash_spaces.public_roots.spaces : space.name => space.space_uuid }
+}
+
+# The directory structure of the spaces whose name starts with "Marketing"
+data "lightdash_spaces" "marketing" {
+  organization_uuid = "xxxx-xxxx-xxxx"
+  project_uuid      = "yyyyy-yyyy-yyyy"
+  name_regex        = "^Marketing"
+  include_tree      = true
+}
+
+output "marketing_tree" {
+  value = jsondecode(data.lightdash_spaces.marketing.tree)
+}
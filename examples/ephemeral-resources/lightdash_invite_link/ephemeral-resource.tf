@@ -0,0 +1,5 @@
+ephemeral "lightdash_invite_link" "contractor" {
+  email           = "contractor@example.com"
+  role            = "viewer"
+  expiration_days = 3
+}
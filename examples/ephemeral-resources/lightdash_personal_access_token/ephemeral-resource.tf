@@ -0,0 +1,5 @@
+# A token that only lives for the duration of the run and is revoked when it ends.
+ephemeral "lightdash_personal_access_token" "deploy" {
+  description        = "terraform run"
+  expiration_minutes = 30
+}
@@ -0,0 +1,19 @@
+resource "lightdash_embed_configuration" "portal" {
+  project_uuid    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  dashboard_uuids = ["yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"]
+}
+
+# A token to open the embedded dashboard locally, valid for an hour after the plan.
+output "embed_url" {
+  sensitive = true
+  value = format(
+    "https://app.lightdash.cloud/embed/%s#%s",
+    lightdash_embed_configuration.portal.project_uuid,
+    provider::lightdash::embed_jwt(
+      lightdash_embed_configuration.portal.secret,
+      "yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy",
+      { country = "JP" },
+      timeadd(plantimestamp(), "1h")
+    )
+  )
+}
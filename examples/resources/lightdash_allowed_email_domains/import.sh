@@ -0,0 +1,2 @@
+# Allowed email domains can be imported by specifying the resource identifier.
+terraform import lightdash_allowed_email_domains.example "organizations/${organization_uuid}/allowed_email_domains"
@@ -0,0 +1,14 @@
+data "lightdash_organization" "current" {}
+
+resource "lightdash_allowed_email_domains" "current" {
+  organization_uuid = data.lightdash_organization.current.organization_uuid
+  email_domains     = ["example.com", "example.co.jp"]
+  role              = "member"
+
+  projects = [
+    {
+      project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+      role         = "viewer"
+    },
+  ]
+}
@@ -0,0 +1,11 @@
+resource "lightdash_content_placement" "example" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+
+  chart_uuids = [
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx",
+  ]
+  dashboard_uuids = [
+    "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx",
+  ]
+}
@@ -0,0 +1,17 @@
+resource "lightdash_project_upstream" "preview" {
+  organization_uuid     = "org-1234567890"
+  project_uuid          = "proj-dev-1234567890"
+  upstream_project_uuid = "proj-prod-1234567890"
+}
+
+# Promote the dashboard, its charts and its space to the upstream project.
+resource "lightdash_content_promotion" "executive_overview" {
+  project_uuid = lightdash_project_upstream.preview.project_uuid
+  content_type = "dashboard"
+  content_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+
+  // Promote again whenever the release changes.
+  triggers = {
+    release = "2026-03-01"
+  }
+}
@@ -0,0 +1,8 @@
+# Upload the charts and dashboards written by `lightdash download`.
+resource "lightdash_content_sync" "analytics" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  directory    = "${path.module}/lightdash"
+
+  // Delete charts and dashboards that are not in the directory.
+  delete_missing = false
+}
@@ -0,0 +1,5 @@
+# Dashboards can be imported by specifying the resource identifier.
+terraform import lightdash_dashboard.example "projects/${project_uuid}/dashboards/${dashboard_uuid}"
+
+# Dashboards can also be imported by the dashboard UUID alone.
+terraform import lightdash_dashboard.example "${dashboard_uuid}"
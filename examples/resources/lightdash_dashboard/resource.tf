@@ -0,0 +1,51 @@
+resource "lightdash_dashboard" "executive_overview" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = lightdash_space.executive.space_uuid
+  name         = "Executive overview"
+  description  = "Company-wide KPIs"
+
+  tabs = ["Summary", "Details"]
+
+  tiles = [
+    {
+      type             = "saved_chart"
+      tab              = "Summary"
+      x                = 0
+      y                = 0
+      width            = 18
+      height           = 9
+      saved_chart_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+    },
+    {
+      type    = "markdown"
+      tab     = "Summary"
+      x       = 18
+      y       = 0
+      width   = 18
+      height  = 9
+      title   = "About this dashboard"
+      content = "Numbers are refreshed daily."
+    },
+    {
+      type   = "loom"
+      tab    = "Details"
+      x      = 0
+      y      = 0
+      width  = 36
+      height = 12
+      title  = "Walkthrough"
+      url    = "https://www.loom.com/share/xxxxxxxxxxxxxxxx"
+    },
+  ]
+
+  filters = [
+    {
+      field_id   = "orders_status"
+      table_name = "orders"
+      operator   = "equals"
+      values     = ["completed"]
+    },
+  ]
+
+  deletion_protection = true
+}
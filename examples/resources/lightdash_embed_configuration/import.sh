@@ -0,0 +1,2 @@
+# The embed configuration can be imported by specifying the resource identifier.
+terraform import lightdash_embed_configuration.example "projects/${project_uuid}/embed_configuration"
@@ -0,0 +1,8 @@
+resource "lightdash_embed_configuration" "portal" {
+  project_uuid    = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  dashboard_uuids = ["yyyyyyyy-yyyy-yyyy-yyyy-yyyyyyyyyyyy"]
+  chart_uuids     = ["zzzzzzzz-zzzz-zzzz-zzzz-zzzzzzzzzzzz"]
+
+  # Change the value to rotate the embed secret.
+  secret_rotation_trigger = "2024-01"
+}
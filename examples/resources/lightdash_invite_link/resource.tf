@@ -0,0 +1,10 @@
+resource "lightdash_invite_link" "contractor" {
+  email           = "contractor@example.com"
+  role            = "viewer"
+  expiration_days = 3
+}
+
+output "contractor_invite_url" {
+  value     = lightdash_invite_link.contractor.invite_url
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Color palettes can be imported by specifying the resource identifier.
+terraform import lightdash_organization_color_palette.example "organizations/${organization_uuid}/color_palettes/${color_palette_uuid}"
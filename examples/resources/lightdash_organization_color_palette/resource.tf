@@ -0,0 +1,10 @@
+resource "lightdash_organization_color_palette" "corporate" {
+  name = "Corporate"
+  colors = [
+    "#1A1A2E",
+    "#16213E",
+    "#0F3460",
+    "#E94560",
+  ]
+  is_active = true
+}
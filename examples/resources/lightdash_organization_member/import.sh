@@ -0,0 +1,2 @@
+# Organization members can be imported by specifying the resource identifier.
+terraform import lightdash_organization_member.example "organizations/${organization_uuid}/members/${user_uuid}"
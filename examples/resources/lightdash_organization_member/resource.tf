@@ -0,0 +1,12 @@
+data "lightdash_organization" "current" {}
+
+resource "lightdash_organization_member" "jane" {
+  organization_uuid = data.lightdash_organization.current.organization_uuid
+  email             = "jane@example.com"
+  role              = "editor"
+
+  resend_expired_invite  = true
+  invite_expiration_days = 7
+
+  deletion_protection = true
+}
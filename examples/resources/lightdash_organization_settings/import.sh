@@ -0,0 +1,2 @@
+# Organization settings can be imported by specifying the resource identifier.
+terraform import lightdash_organization_settings.example "organizations/${organization_uuid}/settings"
@@ -0,0 +1,7 @@
+data "lightdash_organization" "current" {}
+
+resource "lightdash_organization_settings" "current" {
+  organization_uuid    = data.lightdash_organization.current.organization_uuid
+  name                 = "Acme"
+  default_project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+}
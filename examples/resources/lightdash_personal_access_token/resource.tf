@@ -0,0 +1,6 @@
+# A token for a CI job, valid for 90 days and rotated on the first apply after 60 days.
+resource "lightdash_personal_access_token" "ci" {
+  description     = "dbt CI"
+  expiration_days = 90
+  rotation_days   = 60
+}
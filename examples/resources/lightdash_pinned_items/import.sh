@@ -0,0 +1,2 @@
+# Pinned items can be imported by specifying the resource identifier.
+terraform import lightdash_pinned_items.example "projects/${project_uuid}/pinned_items"
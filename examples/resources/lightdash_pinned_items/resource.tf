@@ -0,0 +1,18 @@
+resource "lightdash_pinned_items" "homepage" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+
+  items = [
+    {
+      type = "dashboard"
+      uuid = lightdash_dashboard.executive_overview.dashboard_uuid
+    },
+    {
+      type = "chart"
+      uuid = "yyyyyyyy-yyyyyyyyyy-yyyyyyyyy"
+    },
+    {
+      type = "space"
+      uuid = lightdash_space.executive.space_uuid
+    },
+  ]
+}
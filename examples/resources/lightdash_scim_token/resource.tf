@@ -0,0 +1,11 @@
+data "lightdash_organization" "current" {}
+
+# A token for Okta provisioning, valid for 180 days and rotated on the first apply after 150 days.
+# Plans warn once the token expires within 30 days.
+resource "lightdash_scim_token" "okta" {
+  organization_uuid   = data.lightdash_organization.current.organization_uuid
+  description         = "Okta provisioning"
+  expiration_days     = 180
+  rotation_days       = 150
+  expiry_warning_days = 30
+}
@@ -0,0 +1,20 @@
+data "lightdash_organization" "current" {}
+
+resource "lightdash_service_account" "automation" {
+  organization_uuid = data.lightdash_organization.current.organization_uuid
+  description       = "terraform automation"
+  scopes            = ["org:admin"]
+  expiration_days   = 90
+  rotation_days     = 60
+}
+
+# Use the service account as the credential of a second provider configuration.
+provider "lightdash" {
+  alias = "automation"
+  host  = "https://app.lightdash.cloud"
+  token = lightdash_service_account.automation.token
+}
+
+data "lightdash_organization" "automation" {
+  provider = lightdash.automation
+}
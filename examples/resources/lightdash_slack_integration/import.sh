@@ -0,0 +1,2 @@
+# The Slack integration can be imported by specifying the resource identifier.
+terraform import lightdash_slack_integration.example "organizations/${organization_uuid}/slack_integration"
@@ -0,0 +1,13 @@
+data "lightdash_organization" "current" {}
+
+data "lightdash_slack_channels" "all" {
+  organization_uuid = data.lightdash_organization.current.organization_uuid
+}
+
+resource "lightdash_slack_integration" "current" {
+  organization_uuid        = data.lightdash_organization.current.organization_uuid
+  notification_channel_id  = data.lightdash_slack_channels.all.channel_ids_by_name["data-alerts"]
+  ai_default_channel_id    = data.lightdash_slack_channels.all.channel_ids_by_name["ask-data"]
+  app_profile_photo_url    = "https://example.com/lightdash-bot.png"
+  ai_thread_access_consent = true
+}
@@ -27,6 +27,12 @@ resource "lightdash_space" "test_private" {
     user_uuid  = "xxxxxxxxxxx-xxxxxxxxxxxx-xxxxxxxxxx"
     space_role = "viewer"
   }
+
+  // Users can also be referenced by email
+  access {
+    email      = "analyst@example.com"
+    space_role = "viewer"
+  }
 }
 
 ##########################################################################
@@ -61,3 +67,36 @@ resource "lightdash_space" "test_child_space_restricted" {
     space_role = "editor"
   }
 }
+
+##########################################################################
+# Force destroy
+##########################################################################
+// Destroying this space moves its child spaces, charts and dashboards
+// to the parent space, or to the fallback space when set.
+resource "lightdash_space" "test_force_destroy_relocate" {
+  project_uuid        = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name                = "zzz_test_force_destroy_relocate"
+  is_private          = false
+  deletion_protection = false
+
+  force_destroy                     = "relocate"
+  force_destroy_fallback_space_uuid = lightdash_space.test_public.space_uuid
+}
+
+// Destroying this space deletes its child spaces, charts and dashboards.
+// The plan shows a warning listing everything that will be lost.
+resource "lightdash_space" "test_force_destroy_delete" {
+  project_uuid        = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  name                = "zzz_test_force_destroy_delete"
+  is_private          = false
+  deletion_protection = false
+
+  force_destroy = "delete"
+}
+
+// Users who can see the private space, including access through groups and roles.
+output "test_private_effective_access" {
+  value = {
+    for access in lightdash_space.test_private.effective_access : access.user_uuid => "${access.space_role} (${access.source})"
+  }
+}
@@ -0,0 +1,2 @@
+# Space access grants can be imported by specifying the resource identifier.
+terraform import lightdash_space_access.example "projects/${project-uuid}/spaces/${space_uuid}/access/${user_uuid}"
@@ -0,0 +1,6 @@
+resource "lightdash_space_access" "example" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  user_uuid    = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_role   = "editor"
+}
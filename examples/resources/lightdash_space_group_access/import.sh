@@ -0,0 +1,2 @@
+# Space group access grants can be imported by specifying the resource identifier.
+terraform import lightdash_space_group_access.example "projects/${project-uuid}/spaces/${space_uuid}/group_access/${group_uuid}"
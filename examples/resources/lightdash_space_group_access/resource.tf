@@ -0,0 +1,7 @@
+// Add our group to a space created by another team, without changing its other grants.
+resource "lightdash_space_group_access" "example" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  group_uuid   = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_role   = "viewer"
+}
@@ -0,0 +1,3 @@
+# Space paths can be imported by specifying the resource identifier.
+# Imported spaces are never deleted by the resource.
+terraform import lightdash_space_path.example "projects/${project-uuid}/space_paths/${path}"
@@ -0,0 +1,14 @@
+resource "lightdash_space_path" "example" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  path         = "Marketing/Campaigns/2026"
+  is_private   = true
+
+  // Delete the spaces created by this resource when it is destroyed.
+  delete_created_spaces = true
+}
+
+resource "lightdash_space" "reports" {
+  project_uuid      = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  parent_space_uuid = lightdash_space_path.example.space_uuid
+  name              = "Reports"
+}
@@ -0,0 +1,34 @@
+resource "lightdash_space_tree" "example" {
+  project_uuid        = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  deletion_protection = true
+
+  spaces = [
+    {
+      name       = "Marketing"
+      is_private = true
+      group_access = [
+        {
+          group_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+          space_role = "editor"
+        },
+      ]
+      children = [
+        {
+          // A stable key lets the space be renamed or moved without being recreated.
+          key  = "marketing_campaigns"
+          name = "Campaigns"
+          children = [
+            {
+              key  = "marketing_campaigns_archive"
+              name = "Archive"
+            },
+          ]
+        },
+      ]
+    },
+    {
+      name       = "Sales"
+      is_private = false
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# SQL charts can be imported by specifying the resource identifier.
+terraform import lightdash_sql_chart.example "projects/${project_uuid}/sql_charts/${saved_sql_uuid}"
@@ -0,0 +1,26 @@
+resource "lightdash_sql_chart" "weekly_orders" {
+  project_uuid = "xxxxxxxx-xxxxxxxxxx-xxxxxxxxx"
+  space_uuid   = lightdash_space.operations.space_uuid
+  name         = "Weekly orders"
+  description  = "Orders per week"
+
+  sql = <<-SQL
+    select
+      date_trunc('week', created_at) as week,
+      count(*) as orders
+    from orders
+    group by 1
+  SQL
+
+  limit = 500
+
+  config = jsonencode({
+    metadata = {
+      version = 1
+    }
+    type    = "table"
+    columns = {}
+  })
+
+  deletion_protection = true
+}
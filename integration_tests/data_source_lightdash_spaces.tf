@@ -27,3 +27,22 @@ data "lightdash_spaces" "test" {
 output "lightdash_spaces__test" {
   value = data.lightdash_spaces.test
 }
+
+data "lightdash_spaces" "test_public_roots" {
+  organization_uuid = data.lightdash_projects.test.organization_uuid
+  project_uuid      = var.test_lightdash_project_uuid
+  root_only         = true
+  is_private        = false
+  include_tree      = true
+
+  depends_on = [
+    lightdash_space.test_public,
+  ]
+}
+
+output "lightdash_spaces__test_public_roots" {
+  value = {
+    spaces = data.lightdash_spaces.test_public_roots.spaces
+    tree   = jsondecode(data.lightdash_spaces.test_public_roots.tree)
+  }
+}
@@ -0,0 +1,53 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+// A dashboard created in one space and kept in another by a content placement
+resource "lightdash_space" "test_content_placement" {
+  project_uuid        = var.test_lightdash_project_uuid
+  name                = "zzz_test_content_placement"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_dashboard" "test_content_placement" {
+  project_uuid = var.test_lightdash_project_uuid
+  space_uuid   = lightdash_space.test_public.space_uuid
+  name         = "zzz_test_content_placement"
+
+  tiles = [
+    {
+      type    = "markdown"
+      x       = 0
+      y       = 0
+      width   = 36
+      height  = 3
+      title   = "Notes"
+      content = "Placed by Terraform."
+    },
+  ]
+
+  deletion_protection = false
+
+  lifecycle {
+    // The placement owns the space of the dashboard
+    ignore_changes = [space_uuid]
+  }
+}
+
+resource "lightdash_content_placement" "test" {
+  project_uuid    = var.test_lightdash_project_uuid
+  space_uuid      = lightdash_space.test_content_placement.space_uuid
+  dashboard_uuids = [lightdash_dashboard.test_content_placement.dashboard_uuid]
+}
@@ -0,0 +1,23 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Set test_lightdash_upstream_project_uuid in testing.tfvars to promote the test
+# dashboard to the upstream project. Leave it unset/null to skip.
+resource "lightdash_content_promotion" "test" {
+  count = var.test_lightdash_upstream_project_uuid != null && var.test_lightdash_upstream_project_uuid != "" ? 1 : 0
+
+  project_uuid = lightdash_project_upstream.test[0].project_uuid
+  content_type = "dashboard"
+  content_uuid = lightdash_dashboard.test.dashboard_uuid
+}
@@ -0,0 +1,46 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+resource "lightdash_content_sync" "test" {
+  project_uuid = var.test_lightdash_project_uuid
+
+  documents = [
+    yamlencode({
+      version     = 1
+      name        = "zzz_test_content_sync_dashboard"
+      description = "Uploaded by the integration tests"
+      slug        = "zzz-test-content-sync-dashboard"
+      spaceSlug   = "zzz-test-content-sync"
+      tabs        = []
+      filters = {
+        dimensions        = []
+        metrics           = []
+        tableCalculations = []
+      }
+      tiles = [
+        {
+          type = "markdown"
+          x    = 0
+          y    = 0
+          w    = 36
+          h    = 3
+          properties = {
+            title   = "Notes"
+            content = "Managed by Terraform."
+          }
+        },
+      ]
+    }),
+  ]
+}
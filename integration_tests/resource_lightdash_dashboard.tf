@@ -0,0 +1,46 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+resource "lightdash_dashboard" "test" {
+  project_uuid = var.test_lightdash_project_uuid
+  space_uuid   = lightdash_space.test_public.space_uuid
+  name         = "zzz_test_dashboard"
+  description  = "Dashboard managed by the integration tests"
+
+  tabs = ["Summary", "Video"]
+
+  tiles = [
+    {
+      type    = "markdown"
+      tab     = "Summary"
+      x       = 0
+      y       = 0
+      width   = 36
+      height  = 3
+      title   = "Notes"
+      content = "Managed by Terraform."
+    },
+    {
+      type   = "loom"
+      tab    = "Video"
+      x      = 0
+      y      = 0
+      width  = 36
+      height = 12
+      url    = "https://www.loom.com/share/0123456789abcdef"
+    },
+  ]
+
+  deletion_protection = false
+}
@@ -0,0 +1,29 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+resource "lightdash_embed_configuration" "test" {
+  project_uuid            = var.test_lightdash_project_uuid
+  allow_all_charts        = true
+  secret_rotation_trigger = "2024-01"
+}
+
+output "embed_jwt_example" {
+  sensitive = true
+  value = provider::lightdash::embed_jwt(
+    lightdash_embed_configuration.test.secret,
+    "00000000-0000-0000-0000-000000000000",
+    { country = "JP" },
+    timeadd(plantimestamp(), "1h")
+  )
+}
@@ -130,3 +130,24 @@ resource "lightdash_space" "test_grandchild_space" {
 
   deletion_protection = false
 }
+
+# Destroying the space moves its child spaces, charts and dashboards to the public test space.
+resource "lightdash_space" "test_force_destroy_relocate" {
+  project_uuid = var.test_lightdash_project_uuid
+  name         = "zzz_test_force_destroy_relocate_space"
+  is_private   = false
+
+  deletion_protection               = false
+  force_destroy                     = "relocate"
+  force_destroy_fallback_space_uuid = lightdash_space.test_public.space_uuid
+}
+
+# Destroying the space deletes its child spaces, charts and dashboards.
+resource "lightdash_space" "test_force_destroy_delete" {
+  project_uuid      = var.test_lightdash_project_uuid
+  name              = "zzz_test_force_destroy_delete_space"
+  parent_space_uuid = lightdash_space.test_force_destroy_relocate.space_uuid
+
+  deletion_protection = false
+  force_destroy       = "delete"
+}
@@ -0,0 +1,36 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+// A space whose grants are managed by standalone resources instead of access blocks
+resource "lightdash_space" "test_additive_grants" {
+  project_uuid        = var.test_lightdash_project_uuid
+  name                = "zzz_test_additive_grants"
+  is_private          = true
+  deletion_protection = false
+  manage_access       = false
+}
+
+resource "lightdash_space_group_access" "test" {
+  project_uuid = var.test_lightdash_project_uuid
+  space_uuid   = lightdash_space.test_additive_grants.space_uuid
+  group_uuid   = lightdash_group.test1.group_uuid
+  space_role   = "viewer"
+}
+
+resource "lightdash_space_access" "test" {
+  project_uuid = var.test_lightdash_project_uuid
+  space_uuid   = lightdash_space.test_additive_grants.space_uuid
+  user_uuid    = data.lightdash_authenticated_user.test.user_uuid
+  space_role   = "editor"
+}
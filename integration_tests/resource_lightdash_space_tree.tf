@@ -0,0 +1,49 @@
+# Copyright 2023 Ubie, inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      https://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+resource "lightdash_space_tree" "test" {
+  project_uuid        = var.test_lightdash_project_uuid
+  deletion_protection = false
+
+  spaces = [
+    {
+      key        = "zzz_test_space_tree_marketing"
+      name       = "zzz_test_space_tree_marketing"
+      is_private = true
+      group_access = [
+        {
+          group_uuid = lightdash_group.test1.group_uuid
+          space_role = "editor"
+        },
+      ]
+      children = [
+        {
+          key  = "zzz_test_space_tree_campaigns"
+          name = "zzz_test_space_tree_campaigns"
+          children = [
+            {
+              key  = "zzz_test_space_tree_archive"
+              name = "zzz_test_space_tree_archive"
+            },
+          ]
+        },
+      ]
+    },
+    {
+      key        = "zzz_test_space_tree_sales"
+      name       = "zzz_test_space_tree_sales"
+      is_private = false
+    },
+  ]
+}
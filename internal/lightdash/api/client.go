@@ -19,9 +19,14 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
+// The prefix of service account tokens, which Lightdash authenticates as bearer tokens
+// rather than as personal access tokens.
+const serviceAccountTokenPrefix = "ldsvc_"
+
 type Client struct {
 	HTTPClient *http.Client
 	HostUrl    string
@@ -72,7 +77,7 @@ func (c *Client) DoRequest(req *http.Request) ([]byte, error) {
 
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Authorization", fmt.Sprintf("ApiKey %s", c.Token))
+	req.Header.Add("Authorization", authorizationHeader(c.Token))
 
 	res, err := c.HTTPClient.Do(req) // #nosec G704
 	if err != nil {
@@ -102,3 +107,11 @@ func (c *Client) DoRequest(req *http.Request) ([]byte, error) {
 	// Error response codes
 	return nil, fmt.Errorf("unexpected status code: %d, body: %s", res.StatusCode, body)
 }
+
+// authorizationHeader returns the Authorization header value for a personal access token or a service account token.
+func authorizationHeader(token string) string {
+	if strings.HasPrefix(token, serviceAccountTokenPrefix) {
+		return fmt.Sprintf("Bearer %s", token)
+	}
+	return fmt.Sprintf("ApiKey %s", token)
+}
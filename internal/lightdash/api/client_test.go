@@ -104,3 +104,15 @@ func TestNewClient(t *testing.T) {
 		t.Errorf("Expected empty Token, got: %s", client.Token)
 	}
 }
+
+func TestAuthorizationHeader(t *testing.T) {
+	if got := authorizationHeader("ldpat_abc123"); got != "ApiKey ldpat_abc123" {
+		t.Errorf("Expected ApiKey header for a personal access token, got: %s", got)
+	}
+	if got := authorizationHeader("abc123"); got != "ApiKey abc123" {
+		t.Errorf("Expected ApiKey header for a legacy token, got: %s", got)
+	}
+	if got := authorizationHeader("ldsvc_abc123"); got != "Bearer ldsvc_abc123" {
+		t.Errorf("Expected Bearer header for a service account token, got: %s", got)
+	}
+}
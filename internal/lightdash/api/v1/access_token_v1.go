@@ -0,0 +1,182 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// AccessTokenEndpointV1 is a token API that lists, creates, rotates and deletes tokens under one path.
+// Personal access tokens and SCIM access tokens share this API.
+type AccessTokenEndpointV1 struct {
+	// Path is the path of the token collection below /api/v1
+	Path string
+	// Name is the kind of token, used in error messages
+	Name string
+	// HasAutoGenerated is true when the create request takes an autoGenerated flag
+	HasAutoGenerated bool
+}
+
+// PersonalAccessTokensV1 manages the personal access tokens of the authenticated user
+var PersonalAccessTokensV1 = AccessTokenEndpointV1{
+	Path:             "user/me/personal-access-tokens",
+	Name:             "personal access token",
+	HasAutoGenerated: true,
+}
+
+// ScimTokensV1 manages the SCIM access tokens of the organization
+var ScimTokensV1 = AccessTokenEndpointV1{
+	Path: "scim/organization-access-tokens",
+	Name: "SCIM token",
+}
+
+type AccessTokenV1 struct {
+	UUID string `json:"uuid"`
+	// Only SCIM access tokens belong to an organization
+	OrganizationUUID string  `json:"organizationUuid,omitempty"`
+	Description      string  `json:"description"`
+	ExpiresAt        *string `json:"expiresAt"`
+	CreatedAt        string  `json:"createdAt"`
+	RotatedAt        *string `json:"rotatedAt"`
+	LastUsedAt       *string `json:"lastUsedAt"`
+}
+
+// AccessTokenWithTokenV1 is an access token together with its value,
+// which Lightdash only returns when the token is created or rotated.
+type AccessTokenWithTokenV1 struct {
+	AccessTokenV1
+	Token string `json:"token"`
+}
+
+type ListAccessTokensV1Response struct {
+	Results []AccessTokenV1 `json:"results,omitempty"`
+	Status  string          `json:"status"`
+}
+
+type AccessTokenWithTokenV1Response struct {
+	Results AccessTokenWithTokenV1 `json:"results,omitempty"`
+	Status  string                 `json:"status"`
+}
+
+type CreateAccessTokenV1Request struct {
+	Description   string  `json:"description"`
+	ExpiresAt     *string `json:"expiresAt"`
+	AutoGenerated *bool   `json:"autoGenerated,omitempty"`
+}
+
+type RotateAccessTokenV1Request struct {
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// ListAccessTokensV1 lists the tokens of the endpoint. The token values are not returned.
+func ListAccessTokensV1(c *api.Client, endpoint AccessTokenEndpointV1) ([]AccessTokenV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/%s", c.HostUrl, endpoint.Path), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list %ss request: %w", endpoint.Name, err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("list %ss request failed: %w", endpoint.Name, err)
+	}
+
+	response := ListAccessTokensV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list %ss response: %w", endpoint.Name, err)
+	}
+
+	return response.Results, nil
+}
+
+func CreateAccessTokenV1(c *api.Client, endpoint AccessTokenEndpointV1, request CreateAccessTokenV1Request) (*AccessTokenWithTokenV1, error) {
+	if endpoint.HasAutoGenerated && request.AutoGenerated == nil {
+		autoGenerated := false
+		request.AutoGenerated = &autoGenerated
+	}
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateAccessTokenV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/%s", c.HostUrl, endpoint.Path), bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create %s request: %w", endpoint.Name, err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create %s request failed: %w", endpoint.Name, err)
+	}
+
+	response := AccessTokenWithTokenV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create %s response: %w", endpoint.Name, err)
+	}
+
+	if response.Results.UUID == "" || response.Results.Token == "" {
+		return nil, fmt.Errorf("created %s has no UUID or token", endpoint.Name)
+	}
+
+	return &response.Results, nil
+}
+
+// RotateAccessTokenV1 replaces the token value and sets a new expiry. The previous value stops working.
+func RotateAccessTokenV1(c *api.Client, endpoint AccessTokenEndpointV1, tokenUUID string, request RotateAccessTokenV1Request) (*AccessTokenWithTokenV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal RotateAccessTokenV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/%s/%s/rotate", c.HostUrl, endpoint.Path, tokenUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rotate %s request: %w", endpoint.Name, err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("rotate %s request failed: %w", endpoint.Name, err)
+	}
+
+	response := AccessTokenWithTokenV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rotate %s response: %w", endpoint.Name, err)
+	}
+
+	if response.Results.Token == "" {
+		return nil, fmt.Errorf("rotated %s has no token", endpoint.Name)
+	}
+
+	return &response.Results, nil
+}
+
+func DeleteAccessTokenV1(c *api.Client, endpoint AccessTokenEndpointV1, tokenUUID string) error {
+	path := fmt.Sprintf("%s/api/v1/%s/%s", c.HostUrl, endpoint.Path, tokenUUID)
+	req, err := http.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create delete %s request: %w", endpoint.Name, err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("delete %s request failed: %w", endpoint.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAccessTokenWithTokenV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"uuid": "token-uuid",
+			"description": "CI deploy",
+			"expiresAt": "2024-03-01T00:00:00.000Z",
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"rotatedAt": null,
+			"lastUsedAt": null,
+			"token": "ldpat_secret"
+		}
+	}`
+
+	var response AccessTokenWithTokenV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results.UUID != "token-uuid" || results.Token != "ldpat_secret" {
+		t.Errorf("unexpected token: %+v", results)
+	}
+	if results.ExpiresAt == nil || *results.ExpiresAt != "2024-03-01T00:00:00.000Z" {
+		t.Errorf("unexpected expiry: %v", results.ExpiresAt)
+	}
+	if results.RotatedAt != nil {
+		t.Errorf("expected rotatedAt to be nil, got %q", *results.RotatedAt)
+	}
+}
+
+func TestAccessTokenWithTokenV1Response_UnmarshalJSON_scimToken(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"uuid": "scim-token-uuid",
+			"organizationUuid": "org-uuid",
+			"description": "Okta",
+			"expiresAt": "2024-07-01T00:00:00.000Z",
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"rotatedAt": null,
+			"lastUsedAt": "2024-01-02T00:00:00.000Z",
+			"token": "scim_secret"
+		}
+	}`
+
+	var response AccessTokenWithTokenV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results.UUID != "scim-token-uuid" || results.OrganizationUUID != "org-uuid" || results.Token != "scim_secret" {
+		t.Errorf("unexpected token: %+v", results)
+	}
+	if results.LastUsedAt == nil {
+		t.Errorf("expected lastUsedAt to be set")
+	}
+}
+
+func TestCreateAccessTokenV1Request_MarshalJSON(t *testing.T) {
+	autoGenerated := false
+	tests := []struct {
+		name     string
+		request  CreateAccessTokenV1Request
+		expected string
+	}{
+		{
+			name:     "personal access token",
+			request:  CreateAccessTokenV1Request{Description: "CI deploy", AutoGenerated: &autoGenerated},
+			expected: `{"description":"CI deploy","expiresAt":null,"autoGenerated":false}`,
+		},
+		{
+			name:     "SCIM token",
+			request:  CreateAccessTokenV1Request{Description: "Okta"},
+			expected: `{"description":"Okta","expiresAt":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.request)
+			if err != nil {
+				t.Fatalf("failed to marshal request: %v", err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("got %s, want %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// ContentAsCodeV1Results is a page of charts or dashboards in the `lightdash download` format.
+// Offset is the offset of the next page.
+type ContentAsCodeV1Results struct {
+	Charts     []map[string]any `json:"charts,omitempty"`
+	Dashboards []map[string]any `json:"dashboards,omitempty"`
+	MissingIDs []string         `json:"missingIds"`
+	Total      int              `json:"total"`
+	Offset     int              `json:"offset"`
+}
+
+type contentAsCodeV1Response struct {
+	Results ContentAsCodeV1Results `json:"results,omitempty"`
+	Status  string                 `json:"status"`
+}
+
+type promotionChangesV1Response struct {
+	Results models.PromotionChanges `json:"results,omitempty"`
+	Status  string                  `json:"status"`
+}
+
+// contentAsCodePathSegment maps the content type to the path segment of the code endpoints.
+func contentAsCodePathSegment(contentType models.ContentType) string {
+	if contentType == models.CONTENT_TYPE_DASHBOARD {
+		return "dashboards"
+	}
+	return "charts"
+}
+
+func getContentAsCodeV1(c *api.Client, projectUUID string, contentType models.ContentType, offset int) (*ContentAsCodeV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/%s/code?offset=%d", c.HostUrl, projectUUID, contentAsCodePathSegment(contentType), offset)
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get %s as code request: %w", contentType, err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get %s as code request failed: %w", contentType, err)
+	}
+
+	response := contentAsCodeV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get %s as code response: %w", contentType, err)
+	}
+
+	return &response.Results, nil
+}
+
+func upsertContentAsCodeV1(c *api.Client, projectUUID string, content models.ContentAsCode) (*models.PromotionChanges, error) {
+	marshalled, err := json.Marshal(content.Document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", content.Key(), err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/projects/%s/%s/%s/code", c.HostUrl, projectUUID, contentAsCodePathSegment(content.Type), content.Slug)
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upsert %s as code request: %w", content.Type, err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("upsert %s as code request failed: %w", content.Key(), err)
+	}
+
+	response := promotionChangesV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal upsert %s as code response: %w", content.Type, err)
+	}
+
+	return &response.Results, nil
+}
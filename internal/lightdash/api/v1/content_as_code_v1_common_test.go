@@ -0,0 +1,71 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestContentAsCodeV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"charts": [
+				{"name": "Weekly orders", "slug": "weekly-orders", "spaceSlug": "operations", "tableName": "orders", "version": 1}
+			],
+			"missingIds": [],
+			"total": 3,
+			"offset": 1
+		}
+	}`
+
+	var response contentAsCodeV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if len(response.Results.Charts) != 1 || response.Results.Charts[0]["slug"] != "weekly-orders" {
+		t.Errorf("unexpected charts: %v", response.Results.Charts)
+	}
+	if response.Results.Total != 3 || response.Results.Offset != 1 {
+		t.Errorf("unexpected pagination: total=%d offset=%d", response.Results.Total, response.Results.Offset)
+	}
+}
+
+func TestPromotionChangesV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"spaces": [{"action": "create", "data": {"uuid": "space-uuid", "name": "Operations", "slug": "operations", "projectUuid": "project-uuid"}}],
+			"dashboards": [],
+			"charts": [{"action": "update", "data": {"uuid": "chart-uuid", "name": "Weekly orders", "slug": "weekly-orders", "projectUuid": "project-uuid", "spaceUuid": "space-uuid"}}]
+		}
+	}`
+
+	var response promotionChangesV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	changes := response.Results
+	if len(changes.Spaces) != 1 || changes.Spaces[0].Action != "create" {
+		t.Errorf("unexpected spaces: %v", changes.Spaces)
+	}
+	if len(changes.Charts) != 1 || changes.Charts[0].Data.UUID != "chart-uuid" {
+		t.Errorf("unexpected charts: %v", changes.Charts)
+	}
+	if changes.Charts[0].Data.SpaceUUID == nil || *changes.Charts[0].Data.SpaceUUID != "space-uuid" {
+		t.Errorf("unexpected chart space UUID: %v", changes.Charts[0].Data.SpaceUUID)
+	}
+}
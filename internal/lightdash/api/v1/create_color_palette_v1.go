@@ -0,0 +1,62 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type CreateColorPaletteV1Request struct {
+	Name   string   `json:"name"`
+	Colors []string `json:"colors"`
+}
+
+type CreateColorPaletteV1Response struct {
+	Results ColorPaletteV1 `json:"results,omitempty"`
+	Status  string         `json:"status"`
+}
+
+func CreateColorPaletteV1(c *api.Client, request CreateColorPaletteV1Request) (*ColorPaletteV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateColorPaletteV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/org/color-palettes", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create color palette request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create color palette request failed: %w", err)
+	}
+
+	response := CreateColorPaletteV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create color palette response: %w", err)
+	}
+
+	if response.Results.ColorPaletteUUID == "" {
+		return nil, fmt.Errorf("created color palette has no UUID")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// EmbedContentV1 is the content that can be embedded from a project.
+type EmbedContentV1 struct {
+	DashboardUUIDs     []string `json:"dashboardUuids"`
+	AllowAllDashboards bool     `json:"allowAllDashboards"`
+	ChartUUIDs         []string `json:"chartUuids"`
+	AllowAllCharts     bool     `json:"allowAllCharts"`
+}
+
+// CreateEmbedConfigV1 creates the embedding configuration of a project with a new secret.
+// When the project already has one, its secret is replaced and the previous secret stops working.
+func CreateEmbedConfigV1(c *api.Client, projectUUID string, request EmbedContentV1) (*EmbedConfigV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal EmbedContentV1: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/embed/%s/config", c.HostUrl, projectUUID)
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create embed config request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create embed config request failed: %w", err)
+	}
+
+	response := EmbedConfigV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create embed config response: %w", err)
+	}
+
+	if response.Results.EncodedSecret == "" {
+		return nil, fmt.Errorf("created embed config has no secret")
+	}
+
+	return &response.Results, nil
+}
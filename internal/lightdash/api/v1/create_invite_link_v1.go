@@ -0,0 +1,74 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type CreateInviteLinkV1Request struct {
+	Email     string                        `json:"email"`
+	Role      models.OrganizationMemberRole `json:"role,omitempty"`
+	ExpiresAt string                        `json:"expiresAt"`
+}
+
+type InviteLinkV1 struct {
+	InviteCode       string `json:"inviteCode"`
+	InviteURL        string `json:"inviteUrl"`
+	ExpiresAt        string `json:"expiresAt"`
+	Email            string `json:"email"`
+	OrganizationUUID string `json:"organizationUuid"`
+	UserUUID         string `json:"userUuid"`
+}
+
+type CreateInviteLinkV1Response struct {
+	Results InviteLinkV1 `json:"results,omitempty"`
+	Status  string       `json:"status"`
+}
+
+// CreateInviteLinkV1 invites the user by email. Inviting a user whose invite is pending sends a new invite.
+func CreateInviteLinkV1(c *api.Client, request CreateInviteLinkV1Request) (*InviteLinkV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateInviteLinkV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/invite-links", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create invite link request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create invite link request failed: %w", err)
+	}
+
+	response := CreateInviteLinkV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create invite link response: %w", err)
+	}
+
+	if response.Results.UserUUID == "" {
+		return nil, fmt.Errorf("invite link has no user UUID")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCreateInviteLinkV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"inviteCode": "invite-code",
+			"inviteUrl": "https://lightdash.example.com/invite/invite-code",
+			"expiresAt": "2024-01-08T00:00:00.000Z",
+			"email": "user@example.com",
+			"organizationUuid": "org-uuid",
+			"userUuid": "user-uuid"
+		}
+	}`
+
+	var response CreateInviteLinkV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if response.Results.UserUUID != "user-uuid" || response.Results.Email != "user@example.com" {
+		t.Errorf("unexpected invite link: %+v", response.Results)
+	}
+	if response.Results.InviteURL == "" {
+		t.Error("expected invite URL to be set")
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type CreateServiceAccountV1Request struct {
+	Description string   `json:"description"`
+	ExpiresAt   *string  `json:"expiresAt"`
+	Scopes      []string `json:"scopes"`
+}
+
+// ServiceAccountWithTokenV1 is a service account together with its token,
+// which Lightdash only returns when the service account is created or its token is rotated.
+type ServiceAccountWithTokenV1 struct {
+	ServiceAccountV1
+	Token string `json:"token"`
+}
+
+type ServiceAccountWithTokenV1Response struct {
+	Results ServiceAccountWithTokenV1 `json:"results,omitempty"`
+	Status  string                    `json:"status"`
+}
+
+func CreateServiceAccountV1(c *api.Client, request CreateServiceAccountV1Request) (*ServiceAccountWithTokenV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateServiceAccountV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/service-accounts", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create service account request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create service account request failed: %w", err)
+	}
+
+	response := ServiceAccountWithTokenV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create service account response: %w", err)
+	}
+
+	if response.Results.UUID == "" || response.Results.Token == "" {
+		return nil, fmt.Errorf("created service account has no UUID or token")
+	}
+
+	return &response.Results, nil
+}
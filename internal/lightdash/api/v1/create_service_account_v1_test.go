@@ -0,0 +1,52 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestServiceAccountWithTokenV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"uuid": "service-account-uuid",
+			"organizationUuid": "org-uuid",
+			"description": "dbt CI",
+			"scopes": ["org:edit", "org:read"],
+			"expiresAt": null,
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"rotatedAt": null,
+			"lastUsedAt": null,
+			"token": "ldsvc_secret"
+		}
+	}`
+
+	var response ServiceAccountWithTokenV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results.UUID != "service-account-uuid" || results.OrganizationUUID != "org-uuid" || results.Token != "ldsvc_secret" {
+		t.Errorf("unexpected service account: %+v", results)
+	}
+	if len(results.Scopes) != 2 || results.Scopes[0] != "org:edit" {
+		t.Errorf("unexpected scopes: %v", results.Scopes)
+	}
+	if results.ExpiresAt != nil {
+		t.Errorf("expected expiresAt to be nil, got %q", *results.ExpiresAt)
+	}
+}
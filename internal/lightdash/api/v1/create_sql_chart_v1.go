@@ -0,0 +1,72 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type CreateSQLChartV1Request struct {
+	Name        string          `json:"name"`
+	Description *string         `json:"description"`
+	SQL         string          `json:"sql"`
+	Limit       int64           `json:"limit"`
+	Config      json.RawMessage `json:"config"`
+	SpaceUUID   string          `json:"spaceUuid"`
+}
+
+type CreateSQLChartV1Results struct {
+	SavedSQLUUID string `json:"savedSqlUuid"`
+	Slug         string `json:"slug"`
+}
+
+type CreateSQLChartV1Response struct {
+	Results CreateSQLChartV1Results `json:"results,omitempty"`
+	Status  string                  `json:"status"`
+}
+
+func CreateSQLChartV1(c *api.Client, projectUUID string, request CreateSQLChartV1Request) (*CreateSQLChartV1Results, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateSQLChartV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/projects/%s/sqlRunner/saved", c.HostUrl, projectUUID)
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create create SQL chart request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("create SQL chart request failed: %w", err)
+	}
+
+	response := CreateSQLChartV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal create SQL chart response: %w", err)
+	}
+
+	if response.Results.SavedSQLUUID == "" {
+		return nil, fmt.Errorf("saved SQL UUID is missing in the create SQL chart response")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type AllowedEmailDomainProjectV1 struct {
+	ProjectUUID string                   `json:"projectUuid"`
+	Role        models.ProjectMemberRole `json:"role"`
+}
+
+type AllowedEmailDomainsV1 struct {
+	OrganizationUUID string                        `json:"organizationUuid,omitempty"`
+	EmailDomains     []string                      `json:"emailDomains"`
+	Role             models.OrganizationMemberRole `json:"role"`
+	Projects         []AllowedEmailDomainProjectV1 `json:"projects"`
+}
+
+type GetAllowedEmailDomainsV1Response struct {
+	Results AllowedEmailDomainsV1 `json:"results,omitempty"`
+	Status  string                `json:"status"`
+}
+
+func GetAllowedEmailDomainsV1(c *api.Client) (*AllowedEmailDomainsV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/org/allowedEmailDomains", c.HostUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get allowed email domains request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get allowed email domains request failed: %w", err)
+	}
+
+	response := GetAllowedEmailDomainsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get allowed email domains response: %w", err)
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestGetAllowedEmailDomainsV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"organizationUuid": "org-uuid",
+			"emailDomains": ["example.com"],
+			"role": "member",
+			"projects": [{"projectUuid": "project-uuid", "role": "viewer"}]
+		}
+	}`
+
+	var response GetAllowedEmailDomainsV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if len(results.EmailDomains) != 1 || results.EmailDomains[0] != "example.com" {
+		t.Errorf("unexpected email domains: %v", results.EmailDomains)
+	}
+	if results.Role != models.ORGANIZATION_MEMBER_ROLE {
+		t.Errorf("unexpected role: %s", results.Role)
+	}
+	if len(results.Projects) != 1 || results.Projects[0].Role != models.PROJECT_VIEWER_ROLE {
+		t.Errorf("unexpected projects: %+v", results.Projects)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type DashboardTabV1 struct {
+	UUID  string `json:"uuid"`
+	Name  string `json:"name"`
+	Order int64  `json:"order"`
+}
+
+// DashboardTilePropertiesV1 is the union of the properties of all tile types.
+// Only the fields relevant to the tile type are set.
+type DashboardTilePropertiesV1 struct {
+	Title          *string `json:"title,omitempty"`
+	HideTitle      *bool   `json:"hideTitle,omitempty"`
+	SavedChartUUID *string `json:"savedChartUuid,omitempty"`
+	SavedSQLUUID   *string `json:"savedSqlUuid,omitempty"`
+	ChartName      *string `json:"chartName,omitempty"`
+	Content        *string `json:"content,omitempty"`
+	URL            *string `json:"url,omitempty"`
+}
+
+type DashboardTileV1 struct {
+	UUID       string                    `json:"uuid,omitempty"`
+	Type       string                    `json:"type"`
+	X          int64                     `json:"x"`
+	Y          int64                     `json:"y"`
+	W          int64                     `json:"w"`
+	H          int64                     `json:"h"`
+	TabUUID    *string                   `json:"tabUuid,omitempty"`
+	Properties DashboardTilePropertiesV1 `json:"properties"`
+}
+
+type DashboardFilterTargetV1 struct {
+	FieldID   string `json:"fieldId"`
+	TableName string `json:"tableName"`
+}
+
+type DashboardFilterRuleV1 struct {
+	ID       string                  `json:"id"`
+	Label    *string                 `json:"label,omitempty"`
+	Target   DashboardFilterTargetV1 `json:"target"`
+	Operator string                  `json:"operator"`
+	Values   []any                   `json:"values,omitempty"`
+	Disabled bool                    `json:"disabled,omitempty"`
+}
+
+type DashboardFiltersV1 struct {
+	Dimensions        []DashboardFilterRuleV1 `json:"dimensions"`
+	Metrics           []DashboardFilterRuleV1 `json:"metrics"`
+	TableCalculations []DashboardFilterRuleV1 `json:"tableCalculations"`
+}
+
+type DashboardV1 struct {
+	UUID             string             `json:"uuid"`
+	OrganizationUUID string             `json:"organizationUuid"`
+	ProjectUUID      string             `json:"projectUuid"`
+	SpaceUUID        string             `json:"spaceUuid"`
+	Name             string             `json:"name"`
+	Description      *string            `json:"description,omitempty"`
+	Tiles            []DashboardTileV1  `json:"tiles"`
+	Tabs             []DashboardTabV1   `json:"tabs"`
+	Filters          DashboardFiltersV1 `json:"filters"`
+	UpdatedAt        string             `json:"updatedAt"`
+}
+
+type GetDashboardV1Response struct {
+	Results DashboardV1 `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+func GetDashboardV1(c *api.Client, dashboardUUID string) (*DashboardV1, error) {
+	if strings.TrimSpace(dashboardUUID) == "" {
+		return nil, fmt.Errorf("dashboard UUID is empty")
+	}
+
+	path := fmt.Sprintf("%s/api/v1/dashboards/%s", c.HostUrl, dashboardUUID)
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get dashboard request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get dashboard request failed: %w", err)
+	}
+
+	response := GetDashboardV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get dashboard response: %w", err)
+	}
+
+	if response.Results.UUID == "" {
+		return nil, fmt.Errorf("dashboard UUID is missing in the get dashboard response")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestGetDashboardV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"uuid": "8f0a3c7e-1d62-4f55-a1a4-2f8d5f0b6a11",
+			"organizationUuid": "089a18c4-667e-41cb-9d10-b088461ac941",
+			"projectUuid": "f58b2903-de95-4bcc-8a11-194a35f31f15",
+			"spaceUuid": "0ca1503b-e5c9-4698-b3db-bd7998974555",
+			"name": "Executive overview",
+			"description": null,
+			"updatedAt": "2026-03-01T09:00:00.000Z",
+			"tabs": [
+				{"uuid": "b1c7e0e2-8a4f-4d0c-9c52-6f7d2b1c9e01", "name": "Summary", "order": 0}
+			],
+			"tiles": [
+				{
+					"uuid": "d0c4b9f2-0b0e-4c5f-8c5d-2a3b4c5d6e7f",
+					"type": "saved_chart",
+					"x": 0, "y": 0, "w": 18, "h": 9,
+					"tabUuid": "b1c7e0e2-8a4f-4d0c-9c52-6f7d2b1c9e01",
+					"properties": {
+						"savedChartUuid": "c3a1f2d4-5b6e-4f7a-8b9c-0d1e2f3a4b5c",
+						"title": "",
+						"hideTitle": false,
+						"belongsToDashboard": false
+					}
+				},
+				{
+					"uuid": "e1d2c3b4-a5f6-4e7d-8c9b-0a1b2c3d4e5f",
+					"type": "markdown",
+					"x": 18, "y": 0, "w": 18, "h": 3,
+					"tabUuid": null,
+					"properties": {"title": "Notes", "content": "# Hello"}
+				}
+			],
+			"filters": {
+				"dimensions": [
+					{
+						"id": "4c2b8f0e-6f9a-4e3d-9b1c-7a5d3e2f1a0b",
+						"label": null,
+						"target": {"fieldId": "orders_status", "tableName": "orders"},
+						"operator": "equals",
+						"values": ["completed", 42],
+						"disabled": false
+					}
+				],
+				"metrics": [],
+				"tableCalculations": []
+			}
+		}
+	}`
+
+	var response GetDashboardV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+
+	dashboard := response.Results
+	if dashboard.UUID != "8f0a3c7e-1d62-4f55-a1a4-2f8d5f0b6a11" {
+		t.Errorf("unexpected UUID: %s", dashboard.UUID)
+	}
+	if dashboard.Description != nil {
+		t.Errorf("expected Description to be nil, got %v", *dashboard.Description)
+	}
+	if len(dashboard.Tabs) != 1 || dashboard.Tabs[0].Name != "Summary" {
+		t.Errorf("unexpected tabs: %v", dashboard.Tabs)
+	}
+	if len(dashboard.Tiles) != 2 {
+		t.Fatalf("expected 2 tiles, got %d", len(dashboard.Tiles))
+	}
+
+	chartTile := dashboard.Tiles[0]
+	if chartTile.Type != "saved_chart" || chartTile.W != 18 || chartTile.H != 9 {
+		t.Errorf("unexpected chart tile: %+v", chartTile)
+	}
+	if chartTile.TabUUID == nil || *chartTile.TabUUID != "b1c7e0e2-8a4f-4d0c-9c52-6f7d2b1c9e01" {
+		t.Errorf("unexpected chart tile tab UUID: %v", chartTile.TabUUID)
+	}
+	if chartTile.Properties.SavedChartUUID == nil || *chartTile.Properties.SavedChartUUID != "c3a1f2d4-5b6e-4f7a-8b9c-0d1e2f3a4b5c" {
+		t.Errorf("unexpected saved chart UUID: %v", chartTile.Properties.SavedChartUUID)
+	}
+
+	markdownTile := dashboard.Tiles[1]
+	if markdownTile.TabUUID != nil {
+		t.Errorf("expected markdown tile tab UUID to be nil, got %v", *markdownTile.TabUUID)
+	}
+	if markdownTile.Properties.Content == nil || *markdownTile.Properties.Content != "# Hello" {
+		t.Errorf("unexpected markdown content: %v", markdownTile.Properties.Content)
+	}
+
+	if len(dashboard.Filters.Dimensions) != 1 {
+		t.Fatalf("expected 1 dimension filter, got %d", len(dashboard.Filters.Dimensions))
+	}
+	filter := dashboard.Filters.Dimensions[0]
+	if filter.Target.FieldID != "orders_status" || filter.Operator != "equals" {
+		t.Errorf("unexpected filter: %+v", filter)
+	}
+	if len(filter.Values) != 2 {
+		t.Errorf("expected 2 filter values, got %v", filter.Values)
+	}
+}
+
+func TestCreateDashboardV1Request_MarshalJSON(t *testing.T) {
+	content := "# Hello"
+	title := ""
+	request := CreateDashboardV1Request{
+		Name:      "Executive overview",
+		SpaceUUID: "0ca1503b-e5c9-4698-b3db-bd7998974555",
+		Tiles: []DashboardTileV1{
+			{
+				Type: "markdown",
+				W:    36,
+				H:    3,
+				Properties: DashboardTilePropertiesV1{
+					Title:   &title,
+					Content: &content,
+				},
+			},
+		},
+		Tabs: []DashboardTabV1{},
+		Filters: DashboardFiltersV1{
+			Dimensions:        []DashboardFilterRuleV1{},
+			Metrics:           []DashboardFilterRuleV1{},
+			TableCalculations: []DashboardFilterRuleV1{},
+		},
+	}
+
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+	jsonStr := string(marshalled)
+
+	for _, expected := range []string{
+		`"spaceUuid":"0ca1503b-e5c9-4698-b3db-bd7998974555"`,
+		`"properties":{"title":"","content":"# Hello"}`,
+		`"tabs":[]`,
+		`"dimensions":[]`,
+	} {
+		if !strings.Contains(jsonStr, expected) {
+			t.Errorf("expected %s in marshalled request, got %s", expected, jsonStr)
+		}
+	}
+	if strings.Contains(jsonStr, `"description"`) {
+		t.Errorf("expected description to be omitted, got %s", jsonStr)
+	}
+	if strings.Contains(jsonStr, `"uuid"`) {
+		t.Errorf("expected tile uuid to be omitted, got %s", jsonStr)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// EmbedConfigV1 is the embedding configuration of a project, including the decoded secret that signs embed JWTs.
+type EmbedConfigV1 struct {
+	ProjectUUID        string   `json:"projectUuid"`
+	EncodedSecret      string   `json:"encodedSecret"`
+	DashboardUUIDs     []string `json:"dashboardUuids"`
+	AllowAllDashboards bool     `json:"allowAllDashboards"`
+	ChartUUIDs         []string `json:"chartUuids"`
+	AllowAllCharts     bool     `json:"allowAllCharts"`
+	CreatedAt          string   `json:"createdAt"`
+}
+
+type EmbedConfigV1Response struct {
+	Results EmbedConfigV1 `json:"results,omitempty"`
+	Status  string        `json:"status"`
+}
+
+func GetEmbedConfigV1(c *api.Client, projectUUID string) (*EmbedConfigV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/embed/%s/config", c.HostUrl, projectUUID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get embed config request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get embed config request failed: %w", err)
+	}
+
+	response := EmbedConfigV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get embed config response: %w", err)
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEmbedConfigV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"projectUuid": "project-uuid",
+			"encodedSecret": "embed-secret",
+			"dashboardUuids": ["dashboard-1", "dashboard-2"],
+			"allowAllDashboards": false,
+			"chartUuids": [],
+			"allowAllCharts": true,
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"user": {"userUuid": "user-uuid", "firstName": "Jane", "lastName": "Doe"}
+		}
+	}`
+
+	var response EmbedConfigV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results.ProjectUUID != "project-uuid" || results.EncodedSecret != "embed-secret" {
+		t.Errorf("unexpected embed config: %+v", results)
+	}
+	if len(results.DashboardUUIDs) != 2 || len(results.ChartUUIDs) != 0 {
+		t.Errorf("unexpected content: %v, %v", results.DashboardUUIDs, results.ChartUUIDs)
+	}
+	if results.AllowAllDashboards || !results.AllowAllCharts {
+		t.Errorf("unexpected allow all flags: %+v", results)
+	}
+}
@@ -23,8 +23,11 @@ import (
 )
 
 type GetMyOrganizationV1Results struct {
-	OrganizationUUID string `json:"organizationUuid"`
-	Name             string `json:"name"`
+	OrganizationUUID   string   `json:"organizationUuid"`
+	Name               string   `json:"name"`
+	ChartColors        []string `json:"chartColors,omitempty"`
+	ColorPaletteUUID   *string  `json:"colorPaletteUuid,omitempty"`
+	DefaultProjectUUID *string  `json:"defaultProjectUuid,omitempty"`
 }
 
 type GetMyOrganizationV1Response struct {
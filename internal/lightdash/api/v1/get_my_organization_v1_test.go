@@ -0,0 +1,61 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetMyOrganizationV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"organizationUuid": "org-uuid",
+			"name": "Acme",
+			"chartColors": ["#5470c6", "#fc8452"],
+			"colorPaletteUuid": "palette-uuid",
+			"defaultProjectUuid": "project-uuid",
+			"needsProject": false
+		}
+	}`
+
+	var response GetMyOrganizationV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results.OrganizationUUID != "org-uuid" || results.Name != "Acme" {
+		t.Errorf("unexpected organization: %+v", results)
+	}
+	if len(results.ChartColors) != 2 {
+		t.Errorf("expected 2 chart colors, got %d", len(results.ChartColors))
+	}
+	if results.ColorPaletteUUID == nil || *results.ColorPaletteUUID != "palette-uuid" {
+		t.Errorf("unexpected color palette UUID: %v", results.ColorPaletteUUID)
+	}
+	if results.DefaultProjectUUID == nil || *results.DefaultProjectUUID != "project-uuid" {
+		t.Errorf("unexpected default project UUID: %v", results.DefaultProjectUUID)
+	}
+
+	// Organizations without settings omit the optional fields.
+	response = GetMyOrganizationV1Response{}
+	if err := json.Unmarshal([]byte(`{"status":"ok","results":{"organizationUuid":"org-uuid","name":"Acme","needsProject":true}}`), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if response.Results.ColorPaletteUUID != nil || response.Results.DefaultProjectUUID != nil {
+		t.Errorf("expected optional fields to be nil, got %+v", response.Results)
+	}
+}
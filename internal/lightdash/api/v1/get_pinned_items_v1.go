@@ -0,0 +1,60 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type PinnedItemDataV1 struct {
+	UUID            string `json:"uuid"`
+	Name            string `json:"name"`
+	PinnedListUUID  string `json:"pinnedListUuid"`
+	PinnedListOrder int64  `json:"pinnedListOrder"`
+}
+
+type PinnedItemV1 struct {
+	Type string           `json:"type"`
+	Data PinnedItemDataV1 `json:"data"`
+}
+
+type GetPinnedItemsV1Response struct {
+	Results []PinnedItemV1 `json:"results,omitempty"`
+	Status  string         `json:"status"`
+}
+
+func GetPinnedItemsV1(c *api.Client, projectUUID string, pinnedListUUID string) ([]PinnedItemV1, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/pinned-lists/%s/items", c.HostUrl, projectUUID, pinnedListUUID)
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get pinned items request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get pinned items request failed: %w", err)
+	}
+
+	response := GetPinnedItemsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get pinned items response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetPinnedItemsV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": [
+			{"type": "space", "data": {"uuid": "space-uuid", "name": "Executive", "pinnedListUuid": "list-uuid", "pinnedListOrder": 1}},
+			{"type": "dashboard", "data": {"uuid": "dashboard-uuid", "name": "Executive overview", "pinnedListUuid": "list-uuid", "pinnedListOrder": 0}}
+		]
+	}`
+
+	var response GetPinnedItemsV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if len(response.Results) != 2 {
+		t.Fatalf("expected 2 pinned items, got %d", len(response.Results))
+	}
+	if response.Results[1].Type != "dashboard" || response.Results[1].Data.PinnedListOrder != 0 {
+		t.Errorf("unexpected pinned item: %+v", response.Results[1])
+	}
+}
@@ -29,6 +29,7 @@ type GetProjectV1Results struct {
 	ProjectType         string  `json:"type"`
 	SchedulerTimezone   string  `json:"schedulerTimezone"`
 	UpstreamProjectUUID *string `json:"upstreamProjectUuid,omitempty"`
+	PinnedListUUID      *string `json:"pinnedListUuid,omitempty"`
 }
 
 type GetProjectV1Response struct {
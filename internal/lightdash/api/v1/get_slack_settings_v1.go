@@ -0,0 +1,63 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// SlackSettingsV1 is the Slack app installation of the organization and its custom settings.
+type SlackSettingsV1 struct {
+	OrganizationUUID       string          `json:"organizationUuid"`
+	SlackTeamName          string          `json:"slackTeamName"`
+	AppName                *string         `json:"appName"`
+	CreatedAt              string          `json:"createdAt"`
+	Scopes                 []string        `json:"scopes"`
+	NotificationChannel    *string         `json:"notificationChannel"`
+	AppProfilePhotoURL     *string         `json:"appProfilePhotoUrl"`
+	AIThreadAccessConsent  *bool           `json:"aiThreadAccessConsent"`
+	AIRequireOAuth         *bool           `json:"aiRequireOAuth"`
+	AIMultiAgentChannelID  *string         `json:"aiMultiAgentChannelId"`
+	ChannelProjectMappings json.RawMessage `json:"slackChannelProjectMappings,omitempty"`
+}
+
+type GetSlackSettingsV1Response struct {
+	Results *SlackSettingsV1 `json:"results,omitempty"`
+	Status  string           `json:"status"`
+}
+
+// GetSlackSettingsV1 returns nil when Slack is not installed in the organization.
+func GetSlackSettingsV1(c *api.Client) (*SlackSettingsV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/slack/", c.HostUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get Slack settings request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get Slack settings request failed: %w", err)
+	}
+
+	response := GetSlackSettingsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get Slack settings response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetSlackSettingsV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"organizationUuid": "org-uuid",
+			"slackTeamName": "Example",
+			"appName": "Lightdash",
+			"createdAt": "2024-01-01T00:00:00.000Z",
+			"scopes": ["channels:read", "chat:write"],
+			"notificationChannel": "C0123",
+			"appProfilePhotoUrl": null,
+			"aiThreadAccessConsent": true,
+			"aiMultiAgentChannelId": "C0456",
+			"slackChannelProjectMappings": [{"projectUuid": "project-uuid", "slackChannelId": "C0789", "availableTags": null}]
+		}
+	}`
+
+	var response GetSlackSettingsV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	results := response.Results
+	if results == nil || results.SlackTeamName != "Example" {
+		t.Fatalf("unexpected settings: %+v", results)
+	}
+	if results.NotificationChannel == nil || *results.NotificationChannel != "C0123" {
+		t.Errorf("unexpected notification channel: %v", results.NotificationChannel)
+	}
+	if results.AppProfilePhotoURL != nil || results.AIRequireOAuth != nil {
+		t.Errorf("expected missing settings to be nil: %+v", results)
+	}
+	if results.AIThreadAccessConsent == nil || !*results.AIThreadAccessConsent {
+		t.Errorf("unexpected AI thread access consent: %v", results.AIThreadAccessConsent)
+	}
+	if len(results.ChannelProjectMappings) == 0 {
+		t.Error("expected the channel project mappings to be kept")
+	}
+}
+
+func TestGetSlackSettingsV1Response_UnmarshalJSON_notInstalled(t *testing.T) {
+	var response GetSlackSettingsV1Response
+	if err := json.Unmarshal([]byte(`{"status": "ok"}`), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if response.Results != nil {
+		t.Errorf("expected no settings, got %+v", response.Results)
+	}
+}
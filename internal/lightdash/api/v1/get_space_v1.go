@@ -50,6 +50,12 @@ type ChildSpace struct {
 	IsPrivate                bool   `json:"isPrivate,omitempty"`
 }
 
+// SpaceContentV1 is a chart or dashboard stored directly in a space
+type SpaceContentV1 struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
 type GetSpaceV1Results struct {
 	// The response doesn't contain the OrganizationUUID right now
 	// OrganizationUUID string              `json:"organizationUuid"`
@@ -62,6 +68,8 @@ type GetSpaceV1Results struct {
 	ChildSpaces              []ChildSpace        `json:"childSpaces"`
 	SpaceAccessMembers       []SpaceAccessMember `json:"access"`
 	SpaceAccessGroups        []SpaceAccessGroup  `json:"groupsAccess"`
+	Queries                  []SpaceContentV1    `json:"queries"`
+	Dashboards               []SpaceContentV1    `json:"dashboards"`
 }
 
 type GetSpaceV1Response struct {
@@ -117,6 +117,12 @@ func TestGetSpaceV1ResultsJSONUnmarshal(t *testing.T) {
 		],
 		"groupsAccess": [
 			{"groupUuid": "group1", "groupName": "Group One", "role": "viewer"}
+		],
+		"queries": [
+			{"uuid": "chart1", "name": "Chart One"}
+		],
+		"dashboards": [
+			{"uuid": "dashboard1", "name": "Dashboard One"}
 		]
 	}`
 
@@ -150,6 +156,12 @@ func TestGetSpaceV1ResultsJSONUnmarshal(t *testing.T) {
 	if len(results.SpaceAccessGroups) != 1 || results.SpaceAccessGroups[0].GroupUUID != "group1" {
 		t.Errorf("expected SpaceAccessGroups[0].GroupUUID to be 'group1', got '%v'", results.SpaceAccessGroups)
 	}
+	if len(results.Queries) != 1 || results.Queries[0].UUID != "chart1" || results.Queries[0].Name != "Chart One" {
+		t.Errorf("expected Queries[0] to be chart1/'Chart One', got '%v'", results.Queries)
+	}
+	if len(results.Dashboards) != 1 || results.Dashboards[0].UUID != "dashboard1" {
+		t.Errorf("expected Dashboards[0].UUID to be 'dashboard1', got '%v'", results.Dashboards)
+	}
 }
 
 func TestGetSpaceV1ResponseInstantiation(t *testing.T) {
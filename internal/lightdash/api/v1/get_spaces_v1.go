@@ -29,6 +29,7 @@ type ListSpacesInProjectV1Results struct {
 	ParentSpaceUUID          *string `json:"parentSpaceUuid,omitempty"`
 	SpaceUUID                string  `json:"uuid"`
 	SpaceName                string  `json:"name"`
+	Slug                     string  `json:"slug,omitempty"`
 	InheritParentPermissions *bool   `json:"inheritParentPermissions,omitempty"`
 	IsPrivate                bool    `json:"isPrivate,omitempty"` // nolint: govet
 }
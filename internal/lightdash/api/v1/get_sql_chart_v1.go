@@ -0,0 +1,81 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type SQLChartSpaceV1 struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type SQLChartProjectV1 struct {
+	ProjectUUID string `json:"projectUuid"`
+}
+
+type SQLChartV1 struct {
+	SavedSQLUUID string            `json:"savedSqlUuid"`
+	Name         string            `json:"name"`
+	Description  *string           `json:"description"`
+	Slug         string            `json:"slug"`
+	SQL          string            `json:"sql"`
+	Limit        int64             `json:"limit"`
+	Config       json.RawMessage   `json:"config"`
+	ChartKind    string            `json:"chartKind"`
+	Space        SQLChartSpaceV1   `json:"space"`
+	Project      SQLChartProjectV1 `json:"project"`
+	CreatedAt    string            `json:"createdAt"`
+	LastUpdated  string            `json:"lastUpdatedAt"`
+}
+
+type GetSQLChartV1Response struct {
+	Results SQLChartV1 `json:"results,omitempty"`
+	Status  string     `json:"status"`
+}
+
+func GetSQLChartV1(c *api.Client, projectUUID string, savedSQLUUID string) (*SQLChartV1, error) {
+	if strings.TrimSpace(savedSQLUUID) == "" {
+		return nil, fmt.Errorf("saved SQL UUID is empty")
+	}
+
+	path := fmt.Sprintf("%s/api/v1/projects/%s/sqlRunner/saved/%s", c.HostUrl, projectUUID, savedSQLUUID)
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create get SQL chart request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("get SQL chart request failed: %w", err)
+	}
+
+	response := GetSQLChartV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal get SQL chart response: %w", err)
+	}
+
+	if response.Results.SavedSQLUUID == "" {
+		return nil, fmt.Errorf("saved SQL UUID is missing in the get SQL chart response")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetSQLChartV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"savedSqlUuid": "5f0e8c1a-2b3d-4e5f-8a9b-0c1d2e3f4a5b",
+			"name": "Weekly orders",
+			"description": null,
+			"slug": "weekly-orders",
+			"sql": "select date_trunc('week', created_at) as week, count(*) as orders\nfrom orders\ngroup by 1",
+			"limit": 500,
+			"config": {"metadata": {"version": 1}, "type": "vertical_bar", "display": {}},
+			"chartKind": "vertical_bar",
+			"space": {"uuid": "0ca1503b-e5c9-4698-b3db-bd7998974555", "name": "Operations"},
+			"project": {"projectUuid": "f58b2903-de95-4bcc-8a11-194a35f31f15"},
+			"createdAt": "2026-03-01T09:00:00.000Z",
+			"lastUpdatedAt": "2026-03-02T09:00:00.000Z"
+		}
+	}`
+
+	var response GetSQLChartV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+
+	chart := response.Results
+	if chart.SavedSQLUUID != "5f0e8c1a-2b3d-4e5f-8a9b-0c1d2e3f4a5b" {
+		t.Errorf("unexpected saved SQL UUID: %s", chart.SavedSQLUUID)
+	}
+	if chart.Description != nil {
+		t.Errorf("expected Description to be nil, got %v", *chart.Description)
+	}
+	if chart.Limit != 500 {
+		t.Errorf("unexpected limit: %d", chart.Limit)
+	}
+	if chart.Space.UUID != "0ca1503b-e5c9-4698-b3db-bd7998974555" {
+		t.Errorf("unexpected space UUID: %s", chart.Space.UUID)
+	}
+	if chart.Project.ProjectUUID != "f58b2903-de95-4bcc-8a11-194a35f31f15" {
+		t.Errorf("unexpected project UUID: %s", chart.Project.ProjectUUID)
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal(chart.Config, &config); err != nil {
+		t.Fatalf("failed to unmarshal config: %v", err)
+	}
+	if config["type"] != "vertical_bar" {
+		t.Errorf("unexpected config type: %v", config["type"])
+	}
+}
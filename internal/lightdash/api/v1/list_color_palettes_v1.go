@@ -0,0 +1,56 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ColorPaletteV1 struct {
+	ColorPaletteUUID string   `json:"colorPaletteUuid"`
+	OrganizationUUID string   `json:"organizationUuid"`
+	Name             string   `json:"name"`
+	Colors           []string `json:"colors"`
+	CreatedAt        string   `json:"createdAt"`
+	IsActive         bool     `json:"isActive"`
+}
+
+type ListColorPalettesV1Response struct {
+	Results []ColorPaletteV1 `json:"results,omitempty"`
+	Status  string           `json:"status"`
+}
+
+func ListColorPalettesV1(c *api.Client) ([]ColorPaletteV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/org/color-palettes", c.HostUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list color palettes request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("list color palettes request failed: %w", err)
+	}
+
+	response := ListColorPalettesV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list color palettes response: %w", err)
+	}
+
+	return response.Results, nil
+}
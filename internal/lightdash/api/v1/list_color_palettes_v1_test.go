@@ -0,0 +1,51 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListColorPalettesV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": [
+			{
+				"colorPaletteUuid": "palette-uuid",
+				"organizationUuid": "org-uuid",
+				"name": "Corporate",
+				"colors": ["#1A1A2E", "#16213E", "#0F3460"],
+				"createdAt": "2024-01-01T00:00:00.000Z",
+				"isActive": true
+			}
+		]
+	}`
+
+	var response ListColorPalettesV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if len(response.Results) != 1 {
+		t.Fatalf("expected 1 color palette, got %d", len(response.Results))
+	}
+	palette := response.Results[0]
+	if palette.ColorPaletteUUID != "palette-uuid" || palette.Name != "Corporate" || !palette.IsActive {
+		t.Errorf("unexpected color palette: %+v", palette)
+	}
+	if len(palette.Colors) != 3 || palette.Colors[0] != "#1A1A2E" {
+		t.Errorf("unexpected colors: %v", palette.Colors)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ListDashboardsInProjectV1Results struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	SpaceUUID string `json:"spaceUuid"`
+}
+
+type ListDashboardsInProjectV1Response struct {
+	Results []ListDashboardsInProjectV1Results `json:"results,omitempty"`
+	Status  string                             `json:"status"`
+}
+
+func ListDashboardsInProjectV1(c *api.Client, projectUUID string) ([]ListDashboardsInProjectV1Results, error) {
+	path := fmt.Sprintf("%s/api/v1/projects/%s/dashboards", c.HostUrl, projectUUID)
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list dashboards request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("list dashboards request failed: %w", err)
+	}
+
+	response := ListDashboardsInProjectV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list dashboards response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type ServiceAccountV1 struct {
+	UUID             string   `json:"uuid"`
+	OrganizationUUID string   `json:"organizationUuid"`
+	Description      string   `json:"description"`
+	Scopes           []string `json:"scopes"`
+	ExpiresAt        *string  `json:"expiresAt"`
+	CreatedAt        string   `json:"createdAt"`
+	RotatedAt        *string  `json:"rotatedAt"`
+	LastUsedAt       *string  `json:"lastUsedAt"`
+}
+
+type ListServiceAccountsV1Response struct {
+	Results []ServiceAccountV1 `json:"results,omitempty"`
+	Status  string             `json:"status"`
+}
+
+// ListServiceAccountsV1 lists the service accounts of the organization. The token values are not returned.
+func ListServiceAccountsV1(c *api.Client) ([]ServiceAccountV1, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/service-accounts", c.HostUrl), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list service accounts request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("list service accounts request failed: %w", err)
+	}
+
+	response := ListServiceAccountsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list service accounts response: %w", err)
+	}
+
+	return response.Results, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type SlackChannelV1 struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ListSlackChannelsV1Response struct {
+	Results []SlackChannelV1 `json:"results,omitempty"`
+	Status  string           `json:"status"`
+}
+
+// ListSlackChannelsV1 lists the channels the Slack app can see, optionally filtered by a search term.
+func ListSlackChannelsV1(c *api.Client, search string, excludeArchived bool) ([]SlackChannelV1, error) {
+	query := url.Values{}
+	if search != "" {
+		query.Set("search", search)
+	}
+	query.Set("excludeArchived", strconv.FormatBool(excludeArchived))
+	query.Set("excludeDms", "true")
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v1/slack/channels?%s", c.HostUrl, query.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create list Slack channels request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("list Slack channels request failed: %w", err)
+	}
+
+	response := ListSlackChannelsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list Slack channels response: %w", err)
+	}
+
+	return response.Results, nil
+}
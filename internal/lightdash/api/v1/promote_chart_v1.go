@@ -0,0 +1,61 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// PromotedChartV1 is the chart created or updated in the upstream project.
+type PromotedChartV1 struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	ProjectUUID string `json:"projectUuid"`
+	SpaceUUID   string `json:"spaceUuid"`
+}
+
+type PromoteChartV1Response struct {
+	Results PromotedChartV1 `json:"results,omitempty"`
+	Status  string          `json:"status"`
+}
+
+// PromoteChartV1 copies the chart to the upstream project of its project.
+func PromoteChartV1(c *api.Client, chartUUID string) (*PromotedChartV1, error) {
+	path := fmt.Sprintf("%s/api/v1/saved/%s/promote", c.HostUrl, chartUUID)
+	req, err := http.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promote chart request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("promote chart request failed: %w", err)
+	}
+
+	response := PromoteChartV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal promote chart response: %w", err)
+	}
+
+	if response.Results.UUID == "" {
+		return nil, fmt.Errorf("chart UUID is missing in the promote chart response")
+	}
+
+	return &response.Results, nil
+}
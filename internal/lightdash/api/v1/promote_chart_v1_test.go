@@ -0,0 +1,44 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPromoteChartV1Response_UnmarshalJSON(t *testing.T) {
+	jsonStr := `{
+		"status": "ok",
+		"results": {
+			"uuid": "c3a1f2d4-5b6e-4f7a-8b9c-0d1e2f3a4b5c",
+			"name": "Weekly orders",
+			"projectUuid": "f58b2903-de95-4bcc-8a11-194a35f31f15",
+			"spaceUuid": "0ca1503b-e5c9-4698-b3db-bd7998974555",
+			"tableName": "orders"
+		}
+	}`
+
+	var response PromoteChartV1Response
+	if err := json.Unmarshal([]byte(jsonStr), &response); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+	if response.Results.UUID != "c3a1f2d4-5b6e-4f7a-8b9c-0d1e2f3a4b5c" {
+		t.Errorf("unexpected UUID: %s", response.Results.UUID)
+	}
+	if response.Results.ProjectUUID != "f58b2903-de95-4bcc-8a11-194a35f31f15" {
+		t.Errorf("unexpected project UUID: %s", response.Results.ProjectUUID)
+	}
+}
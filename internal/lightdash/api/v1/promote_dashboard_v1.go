@@ -0,0 +1,53 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type PromoteDashboardV1Response struct {
+	Results DashboardV1 `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+// PromoteDashboardV1 copies the dashboard and its charts to the upstream project of its project.
+func PromoteDashboardV1(c *api.Client, dashboardUUID string) (*DashboardV1, error) {
+	path := fmt.Sprintf("%s/api/v1/dashboards/%s/promote", c.HostUrl, dashboardUUID)
+	req, err := http.NewRequest(http.MethodPost, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promote dashboard request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("promote dashboard request failed: %w", err)
+	}
+
+	response := PromoteDashboardV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal promote dashboard response: %w", err)
+	}
+
+	if response.Results.UUID == "" {
+		return nil, fmt.Errorf("dashboard UUID is missing in the promote dashboard response")
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type RotateServiceAccountV1Request struct {
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// RotateServiceAccountV1 replaces the token of the service account and sets a new expiry. The previous token stops working.
+func RotateServiceAccountV1(c *api.Client, serviceAccountUUID string, request RotateServiceAccountV1Request) (*ServiceAccountWithTokenV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal RotateServiceAccountV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/service-accounts/%s/rotate", c.HostUrl, serviceAccountUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rotate service account request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("rotate service account request failed: %w", err)
+	}
+
+	response := ServiceAccountWithTokenV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rotate service account response: %w", err)
+	}
+
+	if response.Results.Token == "" {
+		return nil, fmt.Errorf("rotated service account has no token")
+	}
+
+	return &response.Results, nil
+}
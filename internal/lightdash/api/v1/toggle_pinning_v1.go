@@ -0,0 +1,67 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type TogglePinningV1Results struct {
+	ProjectUUID    string  `json:"projectUuid"`
+	PinnedListUUID *string `json:"pinnedListUuid,omitempty"`
+	IsPinned       bool    `json:"isPinned"`
+}
+
+type TogglePinningV1Response struct {
+	Results TogglePinningV1Results `json:"results,omitempty"`
+	Status  string                 `json:"status"`
+}
+
+// TogglePinningV1 pins the item to the project homepage if it is not pinned, and unpins it otherwise.
+func TogglePinningV1(c *api.Client, projectUUID string, item models.PinnedItem) (*TogglePinningV1Results, error) {
+	var path string
+	switch item.Type {
+	case models.PINNED_ITEM_CHART:
+		path = fmt.Sprintf("%s/api/v1/saved/%s/pinning", c.HostUrl, item.UUID)
+	case models.PINNED_ITEM_DASHBOARD:
+		path = fmt.Sprintf("%s/api/v1/dashboards/%s/pinning", c.HostUrl, item.UUID)
+	case models.PINNED_ITEM_SPACE:
+		path = fmt.Sprintf("%s/api/v1/projects/%s/spaces/%s/pinning", c.HostUrl, projectUUID, item.UUID)
+	default:
+		return nil, fmt.Errorf("invalid pinned item type %q", item.Type)
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create toggle pinning request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("toggle pinning request failed: %w", err)
+	}
+
+	response := TogglePinningV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal toggle pinning response: %w", err)
+	}
+
+	return &response.Results, nil
+}
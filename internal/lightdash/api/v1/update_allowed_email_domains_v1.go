@@ -0,0 +1,55 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdateAllowedEmailDomainsV1Response struct {
+	Results AllowedEmailDomainsV1 `json:"results,omitempty"`
+	Status  string                `json:"status"`
+}
+
+func UpdateAllowedEmailDomainsV1(c *api.Client, request AllowedEmailDomainsV1) (*AllowedEmailDomainsV1, error) {
+	// The organization UUID is not part of the update request.
+	request.OrganizationUUID = ""
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal AllowedEmailDomainsV1: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v1/org/allowedEmailDomains", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create update allowed email domains request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("update allowed email domains request failed: %w", err)
+	}
+
+	response := UpdateAllowedEmailDomainsV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal update allowed email domains response: %w", err)
+	}
+
+	return &response.Results, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdateColorPaletteV1Request struct {
+	UUID   string   `json:"uuid"`
+	Name   string   `json:"name"`
+	Colors []string `json:"colors"`
+}
+
+func UpdateColorPaletteV1(c *api.Client, request UpdateColorPaletteV1Request) error {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal UpdateColorPaletteV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/org/color-palettes/%s", c.HostUrl, request.UUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create update color palette request: %w", err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("update color palette request failed: %w", err)
+	}
+
+	return nil
+}
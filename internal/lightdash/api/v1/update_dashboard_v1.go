@@ -0,0 +1,69 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// UpdateDashboardV1Request updates both the dashboard details and its layout.
+// The Lightdash API replaces tiles, tabs and filters as a whole.
+type UpdateDashboardV1Request struct {
+	Name        string             `json:"name"`
+	Description *string            `json:"description,omitempty"`
+	SpaceUUID   string             `json:"spaceUuid"`
+	Tiles       []DashboardTileV1  `json:"tiles"`
+	Tabs        []DashboardTabV1   `json:"tabs"`
+	Filters     DashboardFiltersV1 `json:"filters"`
+}
+
+type UpdateDashboardV1Response struct {
+	Results DashboardV1 `json:"results,omitempty"`
+	Status  string      `json:"status"`
+}
+
+func UpdateDashboardV1(c *api.Client, dashboardUUID string, request UpdateDashboardV1Request) (*DashboardV1, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal UpdateDashboardV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/dashboards/%s", c.HostUrl, dashboardUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create update dashboard request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("update dashboard request failed: %w", err)
+	}
+
+	response := UpdateDashboardV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal update dashboard response: %w", err)
+	}
+
+	if response.Results.UUID == "" {
+		return nil, fmt.Errorf("dashboard UUID is missing in the update dashboard response")
+	}
+
+	return &response.Results, nil
+}
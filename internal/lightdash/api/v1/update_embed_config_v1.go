@@ -0,0 +1,44 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// UpdateEmbedConfigV1 replaces the content that can be embedded from a project. The secret is kept.
+func UpdateEmbedConfigV1(c *api.Client, projectUUID string, request EmbedContentV1) error {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal EmbedContentV1: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/embed/%s/config/dashboards", c.HostUrl, projectUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create update embed config request: %w", err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("update embed config request failed: %w", err)
+	}
+
+	return nil
+}
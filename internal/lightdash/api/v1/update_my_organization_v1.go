@@ -0,0 +1,49 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// UpdateMyOrganizationV1Request holds the organization settings to update. Fields left nil are not changed.
+type UpdateMyOrganizationV1Request struct {
+	Name               *string `json:"name,omitempty"`
+	DefaultProjectUUID *string `json:"defaultProjectUuid,omitempty"`
+	ColorPaletteUUID   *string `json:"colorPaletteUuid,omitempty"`
+}
+
+func UpdateMyOrganizationV1(c *api.Client, request UpdateMyOrganizationV1Request) error {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal UpdateMyOrganizationV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v1/org", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create update organization request: %w", err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("update organization request failed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type UpdatePinnedItemOrderDataV1 struct {
+	UUID            string `json:"uuid"`
+	PinnedListUUID  string `json:"pinnedListUuid"`
+	PinnedListOrder int64  `json:"pinnedListOrder"`
+}
+
+type UpdatePinnedItemOrderV1 struct {
+	Type string                      `json:"type"`
+	Data UpdatePinnedItemOrderDataV1 `json:"data"`
+}
+
+func UpdatePinnedItemsOrderV1(c *api.Client, projectUUID string, pinnedListUUID string, items []UpdatePinnedItemOrderV1) error {
+	marshalled, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pinned items order: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/projects/%s/pinned-lists/%s/items/order", c.HostUrl, projectUUID, pinnedListUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create update pinned items order request: %w", err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("update pinned items order request failed: %w", err)
+	}
+
+	return nil
+}
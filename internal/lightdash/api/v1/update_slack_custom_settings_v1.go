@@ -0,0 +1,52 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// UpdateSlackCustomSettingsV1Request replaces all the custom settings of the Slack app.
+type UpdateSlackCustomSettingsV1Request struct {
+	NotificationChannel    *string         `json:"notificationChannel"`
+	AppProfilePhotoURL     *string         `json:"appProfilePhotoUrl"`
+	AIThreadAccessConsent  *bool           `json:"aiThreadAccessConsent,omitempty"`
+	AIRequireOAuth         *bool           `json:"aiRequireOAuth,omitempty"`
+	AIMultiAgentChannelID  *string         `json:"aiMultiAgentChannelId"`
+	ChannelProjectMappings json.RawMessage `json:"slackChannelProjectMappings,omitempty"`
+}
+
+func UpdateSlackCustomSettingsV1(c *api.Client, request UpdateSlackCustomSettingsV1Request) error {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("failed to marshal UpdateSlackCustomSettingsV1Request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/v1/slack/custom-settings", c.HostUrl), bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create update Slack custom settings request: %w", err)
+	}
+
+	if _, err := c.DoRequest(req); err != nil {
+		return fmt.Errorf("update Slack custom settings request failed: %w", err)
+	}
+
+	return nil
+}
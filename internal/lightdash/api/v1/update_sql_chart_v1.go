@@ -0,0 +1,78 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// UpdateSQLChartUnversionedDataV1 holds the fields that are updated in place.
+type UpdateSQLChartUnversionedDataV1 struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	SpaceUUID   string  `json:"spaceUuid"`
+}
+
+// UpdateSQLChartVersionedDataV1 holds the fields that create a new chart version.
+type UpdateSQLChartVersionedDataV1 struct {
+	SQL    string          `json:"sql"`
+	Limit  int64           `json:"limit"`
+	Config json.RawMessage `json:"config"`
+}
+
+type UpdateSQLChartV1Request struct {
+	UnversionedData *UpdateSQLChartUnversionedDataV1 `json:"unversionedData,omitempty"`
+	VersionedData   *UpdateSQLChartVersionedDataV1   `json:"versionedData,omitempty"`
+}
+
+type UpdateSQLChartV1Results struct {
+	SavedSQLUUID        string `json:"savedSqlUuid"`
+	SavedSQLVersionUUID string `json:"savedSqlVersionUuid"`
+}
+
+type UpdateSQLChartV1Response struct {
+	Results UpdateSQLChartV1Results `json:"results,omitempty"`
+	Status  string                  `json:"status"`
+}
+
+func UpdateSQLChartV1(c *api.Client, projectUUID string, savedSQLUUID string, request UpdateSQLChartV1Request) (*UpdateSQLChartV1Results, error) {
+	marshalled, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal UpdateSQLChartV1Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v1/projects/%s/sqlRunner/saved/%s", c.HostUrl, projectUUID, savedSQLUUID)
+	req, err := http.NewRequest(http.MethodPatch, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create update SQL chart request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("update SQL chart request failed: %w", err)
+	}
+
+	response := UpdateSQLChartV1Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal update SQL chart response: %w", err)
+	}
+
+	return &response.Results, nil
+}
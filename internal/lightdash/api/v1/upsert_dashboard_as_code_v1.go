@@ -0,0 +1,31 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// UpsertDashboardAsCodeV1 creates or updates a dashboard from its `lightdash download` document.
+// Lightdash creates the space referenced by `spaceSlug` when it does not exist.
+func UpsertDashboardAsCodeV1(c *api.Client, projectUUID string, content models.ContentAsCode) (*models.PromotionChanges, error) {
+	if content.Type != models.CONTENT_TYPE_DASHBOARD {
+		return nil, fmt.Errorf("expected a dashboard, got %s", content.Key())
+	}
+	return upsertContentAsCodeV1(c, projectUUID, content)
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+// listContentV2PageSize is the number of items requested per page
+const listContentV2PageSize = 100
+
+type ContentV2Space struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+type ContentV2Item struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	ContentType string `json:"contentType"`
+	// Only charts carry a source ("dbt_explore" or "sql")
+	Source *string        `json:"source,omitempty"`
+	Space  ContentV2Space `json:"space"`
+}
+
+type ContentV2Pagination struct {
+	Page           int `json:"page"`
+	PageSize       int `json:"pageSize"`
+	TotalPageCount int `json:"totalPageCount"`
+	TotalResults   int `json:"totalResults"`
+}
+
+type ListContentV2Results struct {
+	Data       []ContentV2Item     `json:"data"`
+	Pagination ContentV2Pagination `json:"pagination"`
+}
+
+type ListContentV2Response struct {
+	Results ListContentV2Results `json:"results"`
+	Status  string               `json:"status"`
+}
+
+// ListSpaceContentV2 lists the content of the given type stored directly in a space, following every page
+func ListSpaceContentV2(c *api.Client, projectUuid string, spaceUuid string, contentType string) ([]ContentV2Item, error) {
+	if err := requireNonEmpty(projectUuid, "project UUID"); err != nil {
+		return nil, err
+	}
+	if err := requireNonEmpty(spaceUuid, "space UUID"); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("projectUuids", projectUuid)
+	query.Set("spaceUuids", spaceUuid)
+	query.Set("contentTypes", contentType)
+	items, err := listContentV2(c, query)
+	if err != nil {
+		return nil, fmt.Errorf("list content request failed (%s in space %s): %w", contentType, spaceUuid, err)
+	}
+	return items, nil
+}
+
+// ListProjectContentV2 lists the content of the given type in every space of a project, following every page
+func ListProjectContentV2(c *api.Client, projectUuid string, contentType string) ([]ContentV2Item, error) {
+	if err := requireNonEmpty(projectUuid, "project UUID"); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("projectUuids", projectUuid)
+	query.Set("contentTypes", contentType)
+	items, err := listContentV2(c, query)
+	if err != nil {
+		return nil, fmt.Errorf("list content request failed (%s in project %s): %w", contentType, projectUuid, err)
+	}
+	return items, nil
+}
+
+// listContentV2 requests every page of the content matching the query
+func listContentV2(c *api.Client, query url.Values) ([]ContentV2Item, error) {
+	items := []ContentV2Item{}
+	for page := 1; ; page++ {
+		query.Set("page", strconv.Itoa(page))
+		query.Set("pageSize", strconv.Itoa(listContentV2PageSize))
+
+		path := fmt.Sprintf("%s/api/v2/content?%s", c.HostUrl, query.Encode())
+		body, err := doJSONRequest(c, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := ListContentV2Response{}
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal list content response: %w", err)
+		}
+		items = append(items, response.Results.Data...)
+		if len(response.Results.Data) == 0 || page >= response.Results.Pagination.TotalPageCount {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListContentV2Response_UnmarshalJSON(t *testing.T) {
+	const fixture = `{
+		"status": "ok",
+		"results": {
+			"data": [
+				{
+					"uuid": "chart-uuid",
+					"name": "Revenue",
+					"contentType": "chart",
+					"source": "sql",
+					"space": {"uuid": "space-uuid", "name": "Sales"}
+				},
+				{
+					"uuid": "saved-chart-uuid",
+					"name": "Orders",
+					"contentType": "chart",
+					"source": "dbt_explore",
+					"space": {"uuid": "space-uuid", "name": "Sales"}
+				}
+			],
+			"pagination": {"page": 1, "pageSize": 100, "totalPageCount": 1, "totalResults": 2}
+		}
+	}`
+
+	response := ListContentV2Response{}
+	if err := json.Unmarshal([]byte(fixture), &response); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(response.Results.Data) != 2 {
+		t.Fatalf("len = %d, want 2", len(response.Results.Data))
+	}
+	first := response.Results.Data[0]
+	if first.Source == nil || *first.Source != "sql" {
+		t.Errorf("Source = %v, want sql", first.Source)
+	}
+	if first.Space.UUID != "space-uuid" {
+		t.Errorf("Space.UUID = %q, want space-uuid", first.Space.UUID)
+	}
+	if response.Results.Pagination.TotalPageCount != 1 {
+		t.Errorf("TotalPageCount = %d, want 1", response.Results.Pagination.TotalPageCount)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+)
+
+type MoveContentV2Item struct {
+	UUID        string `json:"uuid"`
+	ContentType string `json:"contentType"`
+	// Only charts carry a source ("dbt_explore" or "sql")
+	Source *string `json:"source,omitempty"`
+}
+
+type MoveContentV2Action struct {
+	Type            string  `json:"type"`
+	TargetSpaceUUID *string `json:"targetSpaceUuid"`
+}
+
+type MoveContentV2Request struct {
+	Item   MoveContentV2Item   `json:"item"`
+	Action MoveContentV2Action `json:"action"`
+}
+
+type MoveContentV2Response struct {
+	Status string `json:"status"`
+}
+
+// MoveContentV2 moves a chart or a dashboard to another space using the v2 content API
+func MoveContentV2(c *api.Client, projectUuid string, item MoveContentV2Item, targetSpaceUuid string) error {
+	data := MoveContentV2Request{
+		Item: item,
+		Action: MoveContentV2Action{
+			Type:            "move",
+			TargetSpaceUUID: &targetSpaceUuid,
+		},
+	}
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal MoveContentV2Request: %w", err)
+	}
+
+	path := fmt.Sprintf("%s/api/v2/content/%s/move", c.HostUrl, projectUuid)
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(marshalled))
+	if err != nil {
+		return fmt.Errorf("failed to create move content request: %w", err)
+	}
+
+	body, err := c.DoRequest(req)
+	if err != nil {
+		return fmt.Errorf("move content request failed (%s %s to space %s): %w", item.ContentType, item.UUID, targetSpaceUuid, err)
+	}
+
+	response := MoveContentV2Response{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("failed to unmarshal move content response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoveContentV2Request_MarshalJSON(t *testing.T) {
+	source := "dbt_explore"
+	target := "target-space-uuid"
+
+	tests := []struct {
+		name     string
+		request  MoveContentV2Request
+		expected string
+	}{
+		{
+			name: "chart with source",
+			request: MoveContentV2Request{
+				Item:   MoveContentV2Item{UUID: "chart-uuid", ContentType: "chart", Source: &source},
+				Action: MoveContentV2Action{Type: "move", TargetSpaceUUID: &target},
+			},
+			expected: `{"item":{"uuid":"chart-uuid","contentType":"chart","source":"dbt_explore"},"action":{"type":"move","targetSpaceUuid":"target-space-uuid"}}`,
+		},
+		{
+			name: "dashboard without source",
+			request: MoveContentV2Request{
+				Item:   MoveContentV2Item{UUID: "dashboard-uuid", ContentType: "dashboard"},
+				Action: MoveContentV2Action{Type: "move", TargetSpaceUUID: &target},
+			},
+			expected: `{"item":{"uuid":"dashboard-uuid","contentType":"dashboard"},"action":{"type":"move","targetSpaceUuid":"target-space-uuid"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := json.Marshal(test.request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, string(got))
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -71,6 +72,9 @@ type UpdateSpaceOptions struct {
 	ParentSpaceUUID *string
 	MemberAccess    []models.SpaceAccessMember
 	GroupAccess     []models.SpaceAccessGroup
+	// LeaveAccessUnchanged skips the management of direct member and group access,
+	// so that grants made outside of MemberAccess and GroupAccess are kept.
+	LeaveAccessUnchanged bool
 }
 
 func (o *UpdateSpaceOptions) IsNestedSpace() bool {
@@ -82,8 +86,15 @@ type DeleteSpaceOptions struct {
 	ProjectUUID        string
 	SpaceUUID          string
 	DeletionProtection bool
+	// ForceDestroy is empty unless child spaces and content should be handled on deletion
+	ForceDestroy models.SpaceForceDestroyStrategy
+	// FallbackSpaceUUID receives the relocated contents instead of the parent space
+	FallbackSpaceUUID *string
 }
 
+// maxSpaceTreeDepth guards the recursive walks over nested spaces
+const maxSpaceTreeDepth = 64
+
 // ImportSpaceOptions contains all the options for importing a space
 type ImportSpaceOptions struct {
 	ResourceID string // Format: "projects/{projectUUID}/spaces/{spaceUUID}"
@@ -91,6 +102,8 @@ type ImportSpaceOptions struct {
 
 // CreateSpace creates a new space with the specified properties and access settings.
 // Access settings (memberAccess and groupAccess) are only applied to root spaces.
+// When a step fails, the completed steps are rolled back. If the rollback fails too, the
+// details of the space as it exists are returned along with the errors.
 func (c *SpaceController) CreateSpace(
 	ctx context.Context,
 	options CreateSpaceOptions,
@@ -102,13 +115,14 @@ func (c *SpaceController) CreateSpace(
 	var createdSpaceDetails *models.SpaceDetails
 	var errors []error
 
+	journal := newSpaceJournal()
 	if options.IsNestedSpace() {
-		createdSpaceDetails, errors = c.createNestedSpace(ctx, options)
+		createdSpaceDetails, errors = c.createNestedSpace(ctx, options, journal)
 	} else {
-		createdSpaceDetails, errors = c.createRootSpace(ctx, options)
+		createdSpaceDetails, errors = c.createRootSpace(ctx, options, journal)
 	}
 	if len(errors) > 0 {
-		return nil, errors
+		return c.rollbackSpaceOperation(ctx, journal, options.ProjectUUID, createdSpaceDetails, errors)
 	}
 
 	// Get the final space details to return the complete state
@@ -202,7 +216,10 @@ func (c *SpaceController) GetSpace(ctx context.Context, projectUUID, spaceUUID s
 }
 
 // UpdateSpace updates a space based on whether it's a root or nested space and if its parent is changing.
-// It orchestrates calls to specific update/move functions.
+// It orchestrates calls to specific update/move functions. When a step fails, the completed steps
+// are rolled back and the details of the space as it exists are returned along with the errors.
+// No details are returned when the space could not be read before any change was made. When the
+// space no longer exists, the errors wrap services.ErrSpaceNotFound.
 func (c *SpaceController) UpdateSpace(
 	ctx context.Context,
 	options UpdateSpaceOptions,
@@ -217,6 +234,12 @@ func (c *SpaceController) UpdateSpace(
 		return nil, []error{fmt.Errorf("failed to get current space details for update: %w", err)}
 	}
 
+	// Keeping the current direct grants makes the access management of the updates in place a no-op
+	if options.LeaveAccessUnchanged {
+		options.MemberAccess = directSpaceMemberAccess(currentSpaceDetails)
+		options.GroupAccess = append([]models.SpaceAccessGroup{}, currentSpaceDetails.SpaceAccessGroups...)
+	}
+
 	// Check if the space is currently a root space (ParentSpaceUUID is nil) and if the plan indicates it should become root
 	isCurrentlyRootSpace := !currentSpaceDetails.IsNestedSpace()
 	isBecomingRootSpace := models.IsEmptyStringPointer(options.ParentSpaceUUID)
@@ -229,6 +252,7 @@ func (c *SpaceController) UpdateSpace(
 
 	var errors []error
 
+	journal := newSpaceJournal()
 	if isCurrentlyRootSpace && isBecomingRootSpace {
 		// Scenario 1: Remains a root space - Update properties and access.
 		errors = c.updateRootSpace(
@@ -240,18 +264,23 @@ func (c *SpaceController) UpdateSpace(
 			options.MemberAccess,
 			options.GroupAccess,
 			currentSpaceDetails,
+			journal,
 		)
 	} else if isCurrentlyRootSpace && !isBecomingRootSpace {
 		// Scenario 2: Root space becoming a nested space - Update properties, move and manage access.
 		errors = c.moveRootToNestedSpace(
 			ctx,
 			options,
+			currentSpaceDetails,
+			journal,
 		)
 	} else if !isCurrentlyRootSpace && isBecomingRootSpace {
 		// Scenario 3: Nested space becoming a root space - Move to root and then apply properties and access controls.
 		errors = c.moveNestedToRootSpace(
 			ctx,
 			options,
+			currentSpaceDetails,
+			journal,
 		)
 	} else {
 		// Scenario 4: Nested space staying nested (either same parent or different parent).
@@ -259,24 +288,77 @@ func (c *SpaceController) UpdateSpace(
 			ctx,
 			options,
 			currentSpaceDetails,
+			journal,
 		)
 	}
 
-	// Return the updated space details converted to Terraform state
+	// Roll back the completed steps and return the space as it exists
 	if len(errors) > 0 {
-		return nil, errors
+		rolledBackSpaceDetails, rollbackErrors := c.rollbackSpaceOperation(ctx, journal, options.ProjectUUID, currentSpaceDetails, errors)
+		if rolledBackSpaceDetails == nil {
+			rollbackErrors = append(rollbackErrors, fmt.Errorf("space %s no longer exists: %w", options.SpaceUUID, services.ErrSpaceNotFound))
+		}
+		return rolledBackSpaceDetails, rollbackErrors
 	}
 
-	// Get the final space details to return the complete state
+	// Get the final space details to return the complete state.
+	// The update succeeded, so the planned values are returned when they cannot be read back.
 	actualUpdatedSpaceDetails, err := c.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
 	if err != nil {
-		return nil, []error{fmt.Errorf("failed to get final space details after update: %w", err)}
+		return plannedSpaceDetails(currentSpaceDetails, options), []error{fmt.Errorf("failed to get final space details after update: %w", err)}
 	}
 
 	return actualUpdatedSpaceDetails, nil
 }
 
+// directSpaceMemberAccess returns the members that manageSpaceMemberAccess treats as direct grants.
+func directSpaceMemberAccess(space *models.SpaceDetails) []models.SpaceAccessMember {
+	memberAccess := []models.SpaceAccessMember{}
+	for _, member := range space.SpaceAccessMembers {
+		if member.HasDirectAccess != nil && *member.HasDirectAccess {
+			memberAccess = append(memberAccess, models.SpaceAccessMember{
+				UserUUID:  member.UserUUID,
+				SpaceRole: member.SpaceRole,
+			})
+		}
+	}
+	return memberAccess
+}
+
+// plannedSpaceDetails returns the details of a space after a successful update, built from its
+// details before the update and the update options. Access that is not direct is kept as it was.
+func plannedSpaceDetails(current *models.SpaceDetails, options UpdateSpaceOptions) *models.SpaceDetails {
+	planned := *current
+	planned.SpaceName = options.SpaceName
+	planned.ParentSpaceUUID = options.ParentSpaceUUID
+	if options.IsPrivate != nil {
+		planned.IsPrivate = *options.IsPrivate
+		planned.InheritParentPermissions = !*options.IsPrivate
+	}
+
+	planned.SpaceAccessMembers = []models.SpaceMemberAccess{}
+	for _, member := range current.SpaceAccessMembers {
+		if !member.HasDirectSpaceMemberAccess() {
+			planned.SpaceAccessMembers = append(planned.SpaceAccessMembers, member)
+		}
+	}
+	for _, member := range options.MemberAccess {
+		hasDirectAccess := true
+		planned.SpaceAccessMembers = append(planned.SpaceAccessMembers, models.SpaceMemberAccess{
+			UserUUID:        member.UserUUID,
+			SpaceRole:       member.SpaceRole,
+			HasDirectAccess: &hasDirectAccess,
+		})
+	}
+	planned.SpaceAccessGroups = append([]models.SpaceAccessGroup{}, options.GroupAccess...)
+	return &planned
+}
+
 // DeleteSpace deletes a space if deletion protection is disabled.
+// Without ForceDestroy, a space with child spaces cannot be deleted.
+// With ForceDestroy "relocate", child spaces, charts and dashboards are moved to the fallback
+// space or the parent space first. With "delete", child spaces are deleted depth-first;
+// Lightdash deletes the charts and dashboards of each deleted space along with it.
 func (c *SpaceController) DeleteSpace(ctx context.Context, options DeleteSpaceOptions) error {
 	tflog.Debug(ctx, "(SpaceController.DeleteSpace) Deleting space", map[string]interface{}{
 		"options": options,
@@ -286,20 +368,61 @@ func (c *SpaceController) DeleteSpace(ctx context.Context, options DeleteSpaceOp
 		return fmt.Errorf("cannot delete space %s: deletion protection is enabled", options.SpaceUUID)
 	}
 
-	// Check if the space has any child spaces
-	space, err := c.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
-	if err != nil {
-		return fmt.Errorf("failed to get space details: %w", err)
-	}
-	childSpaces := space.ChildSpaces
-	if len(childSpaces) > 0 {
-		return fmt.Errorf("cannot delete space %s: it has child spaces. Please delete the child spaces first", options.SpaceUUID)
+	switch options.ForceDestroy {
+	case models.SPACE_FORCE_DESTROY_RELOCATE:
+		if err := c.relocateSpaceContents(ctx, options); err != nil {
+			return err
+		}
+	case models.SPACE_FORCE_DESTROY_DELETE:
+		tree, err := c.GetSpaceContentTree(ctx, options.ProjectUUID, options.SpaceUUID)
+		if err != nil {
+			return fmt.Errorf("failed to list space contents: %w", err)
+		}
+		for _, child := range tree.ChildSpaces {
+			if err := c.deleteSpaceTree(ctx, options.ProjectUUID, child); err != nil {
+				return err
+			}
+		}
+	case "":
+		// Check if the space has any child spaces
+		space, err := c.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get space details: %w", err)
+		}
+		childSpaces := space.ChildSpaces
+		if len(childSpaces) > 0 {
+			return fmt.Errorf("cannot delete space %s: it has child spaces. Please delete the child spaces first or set force_destroy", options.SpaceUUID)
+		}
+	default:
+		return fmt.Errorf("unknown force destroy strategy %q", options.ForceDestroy)
 	}
 
 	// Delete the space via the service layer
 	return c.spaceService.DeleteSpace(ctx, options.ProjectUUID, options.SpaceUUID)
 }
 
+// GetSpaceContentTree lists the charts, dashboards and nested spaces under a space recursively.
+func (c *SpaceController) GetSpaceContentTree(ctx context.Context, projectUUID, spaceUUID string) (*models.SpaceContentTree, error) {
+	return c.getSpaceContentTree(ctx, projectUUID, spaceUUID, 0)
+}
+
+// GetSpaceRelocationTarget returns the space that receives the contents of a space
+// destroyed with the "relocate" strategy: the fallback space if set, otherwise the parent space.
+// A nil result means the space is a root space and no fallback space is set.
+func (c *SpaceController) GetSpaceRelocationTarget(ctx context.Context, projectUUID, spaceUUID string, fallbackSpaceUUID *string) (*string, error) {
+	if !models.IsEmptyStringPointer(fallbackSpaceUUID) {
+		return fallbackSpaceUUID, nil
+	}
+	space, err := c.spaceService.GetSpace(ctx, projectUUID, spaceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get space details: %w", err)
+	}
+	if models.IsEmptyStringPointer(space.ParentSpaceUUID) {
+		return nil, nil
+	}
+	return space.ParentSpaceUUID, nil
+}
+
 // ImportSpace imports an existing space by its resource ID.
 // It retrieves the space details and access settings.
 func (c *SpaceController) ImportSpace(ctx context.Context, options ImportSpaceOptions) (*models.SpaceDetails, error) {
@@ -328,6 +451,7 @@ func (c *SpaceController) ImportSpace(ctx context.Context, options ImportSpaceOp
 func (c *SpaceController) createRootSpace(
 	ctx context.Context,
 	options CreateSpaceOptions,
+	journal *spaceJournal,
 ) (*models.SpaceDetails, []error) {
 	tflog.Debug(ctx, "Creating root space", map[string]interface{}{
 		"options": options,
@@ -350,6 +474,7 @@ func (c *SpaceController) createRootSpace(
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to create space: %w", err)}
 	}
+	c.recordSpaceCreation(journal, options.ProjectUUID, createdSpace.SpaceUUID)
 
 	// 3. Manage access for the root-level space after creation.
 	// Use the specific helper functions, passing empty current access lists.
@@ -361,6 +486,7 @@ func (c *SpaceController) createRootSpace(
 		createdSpace.SpaceUUID,
 		options.MemberAccess,
 		[]models.SpaceMemberAccess{}, // No existing direct member access on creation
+		journal,
 	)
 	accessErrors = append(accessErrors, memberErrors...)
 
@@ -370,16 +496,13 @@ func (c *SpaceController) createRootSpace(
 		createdSpace.SpaceUUID,
 		options.GroupAccess,
 		[]models.SpaceAccessGroup{}, // No existing group access on creation
+		journal,
 	)
 	accessErrors = append(accessErrors, groupErrors...)
 
 	if len(accessErrors) > 0 {
-		// If access management fails, try to clean up by deleting the space
-		errDel := c.spaceService.DeleteSpace(ctx, options.ProjectUUID, createdSpace.SpaceUUID)
-		if errDel != nil {
-			accessErrors = append(accessErrors, fmt.Errorf("failed to delete space after access management failure: %w", errDel))
-		}
-		return nil, accessErrors
+		// The caller rolls back the journal, which deletes the space
+		return createdSpace, accessErrors
 	}
 
 	return createdSpace, nil
@@ -389,6 +512,7 @@ func (c *SpaceController) createRootSpace(
 func (c *SpaceController) createNestedSpace(
 	ctx context.Context,
 	options CreateSpaceOptions,
+	journal *spaceJournal,
 ) (*models.SpaceDetails, []error) {
 	tflog.Debug(ctx, "Creating nested space", map[string]interface{}{
 		"options": options,
@@ -405,6 +529,7 @@ func (c *SpaceController) createNestedSpace(
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to create nested space: %w", err)}
 	}
+	c.recordSpaceCreation(journal, options.ProjectUUID, createdSpace.SpaceUUID)
 
 	// 3. Manage access for the nested space after creation.
 	var accessErrors []error
@@ -415,6 +540,7 @@ func (c *SpaceController) createNestedSpace(
 		createdSpace.SpaceUUID,
 		options.MemberAccess,
 		[]models.SpaceMemberAccess{}, // No existing direct member access on creation
+		journal,
 	)
 	accessErrors = append(accessErrors, memberErrors...)
 
@@ -424,16 +550,13 @@ func (c *SpaceController) createNestedSpace(
 		createdSpace.SpaceUUID,
 		options.GroupAccess,
 		[]models.SpaceAccessGroup{}, // No existing group access on creation
+		journal,
 	)
 	accessErrors = append(accessErrors, groupErrors...)
 
 	if len(accessErrors) > 0 {
-		// If access management fails, try to clean up by deleting the space
-		errDel := c.spaceService.DeleteSpace(ctx, options.ProjectUUID, createdSpace.SpaceUUID)
-		if errDel != nil {
-			accessErrors = append(accessErrors, fmt.Errorf("failed to delete space after access management failure: %w", errDel))
-		}
-		return nil, accessErrors
+		// The caller rolls back the journal, which deletes the space
+		return createdSpace, accessErrors
 	}
 
 	return createdSpace, nil
@@ -441,39 +564,80 @@ func (c *SpaceController) createNestedSpace(
 
 // validateSpaceCreation validates space creation parameters
 func (c *SpaceController) validateSpaceCreation(ctx context.Context, options CreateSpaceOptions) []error {
-	var errors []error
+	return c.ValidateSpaceAccess(ctx, options.ProjectUUID, options.MemberAccess, options.GroupAccess)
+}
 
-	// 1.1 Check if the member can become a space member (must be project member, not org admin)
-	projectMembers, err := c.projectService.GetProjectMembers(ctx, options.ProjectUUID)
-	if err != nil {
-		return []error{fmt.Errorf("failed to get project members: %w", err)}
-	}
+// SpaceAccessValidationError describes an access entry that cannot be granted on a space.
+// Exactly one of UserUUID and GroupUUID is set.
+type SpaceAccessValidationError struct {
+	UserUUID  string
+	GroupUUID string
+	Err       error
+}
 
-	for _, member := range options.MemberAccess {
-		// Check if the member is a project member
-		isProjectMember := false
-		for _, projectMember := range projectMembers {
-			if projectMember.UserUUID == member.UserUUID {
-				isProjectMember = true
-				break
-			}
+func (e *SpaceAccessValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SpaceAccessValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateSpaceAccess checks that the members can become space members and that the groups exist.
+// Errors about a single entry are returned as *SpaceAccessValidationError. A group lookup that
+// fails for any reason other than the group not existing is returned as a plain error.
+func (c *SpaceController) ValidateSpaceAccess(
+	ctx context.Context,
+	projectUUID string,
+	memberAccess []models.SpaceAccessMember,
+	groupAccess []models.SpaceAccessGroup,
+) []error {
+	var validationErrors []error
+
+	// 1.1 Check if the member can become a space member (must be project member)
+	if len(memberAccess) > 0 {
+		projectMembers, err := c.projectService.GetProjectMembers(ctx, projectUUID)
+		if err != nil {
+			return []error{fmt.Errorf("failed to get project members: %w", err)}
 		}
-		if !isProjectMember {
-			errors = append(errors, fmt.Errorf("user %s is not a project member", member.UserUUID))
-			continue
+
+		for _, member := range memberAccess {
+			// Check if the member is a project member
+			isProjectMember := false
+			for _, projectMember := range projectMembers {
+				if projectMember.UserUUID == member.UserUUID {
+					isProjectMember = true
+					break
+				}
+			}
+			if !isProjectMember {
+				validationErrors = append(validationErrors, &SpaceAccessValidationError{
+					UserUUID: member.UserUUID,
+					Err:      fmt.Errorf("user %s is not a project member", member.UserUUID),
+				})
+				continue
+			}
 		}
 	}
 
 	// 1.2 Check if the groups exist in the organization
-	for _, group := range options.GroupAccess {
+	for _, group := range groupAccess {
 		_, err := c.organizationGroupsService.GetGroup(ctx, group.GroupUUID)
+		if errors.Is(err, services.ErrOrganizationGroupNotFound) {
+			validationErrors = append(validationErrors, &SpaceAccessValidationError{
+				GroupUUID: group.GroupUUID,
+				Err:       fmt.Errorf("group %s not found: %w", group.GroupUUID, err),
+			})
+			continue
+		}
 		if err != nil {
-			errors = append(errors, fmt.Errorf("group %s not found: %w", group.GroupUUID, err))
+			// The group may exist; the lookup itself failed.
+			validationErrors = append(validationErrors, fmt.Errorf("failed to check group %s: %w", group.GroupUUID, err))
 			continue
 		}
 	}
 
-	return errors
+	return validationErrors
 }
 
 // manageSpaceMemberAccess handles adding, updating, and removing direct member access for a space.
@@ -483,6 +647,7 @@ func (c *SpaceController) manageSpaceMemberAccess(
 	spaceUUID string,
 	newMemberAccess []models.SpaceAccessMember,
 	currentMemberAccess []models.SpaceMemberAccess,
+	journal *spaceJournal,
 ) []error {
 	var errors []error
 
@@ -516,6 +681,11 @@ func (c *SpaceController) manageSpaceMemberAccess(
 			err := c.spaceService.RemoveUserFromSpace(ctx, projectUUID, spaceUUID, userUUID)
 			if err != nil {
 				errors = append(errors, fmt.Errorf("failed to remove direct access for user %s from space %s: %w", userUUID, spaceUUID, err))
+			} else {
+				previousRole := currentDirectMemberMap[userUUID].SpaceRole
+				journal.record(fmt.Sprintf("removal of user %s from space %s", userUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.AddUserToSpace(ctx, projectUUID, spaceUUID, userUUID, previousRole)
+				})
 			}
 			delete(currentDirectMemberMap, userUUID) // Remove from map to avoid re-processing
 		}
@@ -530,6 +700,15 @@ func (c *SpaceController) manageSpaceMemberAccess(
 			err := c.spaceService.AddUserToSpace(ctx, projectUUID, spaceUUID, userUUID, newMember.SpaceRole)
 			if err != nil {
 				errors = append(errors, fmt.Errorf("failed to add/update direct access for user %s to space %s: %w", userUUID, spaceUUID, err))
+			} else if exists {
+				previousRole := currentMember.SpaceRole
+				journal.record(fmt.Sprintf("role change of user %s in space %s", userUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.AddUserToSpace(ctx, projectUUID, spaceUUID, userUUID, previousRole)
+				})
+			} else {
+				journal.record(fmt.Sprintf("access of user %s to space %s", userUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.RemoveUserFromSpace(ctx, projectUUID, spaceUUID, userUUID)
+				})
 			}
 		}
 	}
@@ -544,6 +723,7 @@ func (c *SpaceController) manageSpaceGroupAccess(
 	spaceUUID string,
 	newGroupAccess []models.SpaceAccessGroup,
 	currentGroupAccess []models.SpaceAccessGroup,
+	journal *spaceJournal,
 ) []error {
 	var errors []error
 
@@ -580,6 +760,11 @@ func (c *SpaceController) manageSpaceGroupAccess(
 			err = c.spaceService.RemoveGroupFromSpace(ctx, projectUUID, spaceUUID, groupUUID)
 			if err != nil {
 				errors = append(errors, fmt.Errorf("failed to remove group %s from space %s: %w", groupUUID, spaceUUID, err))
+			} else {
+				previousRole := currentGroupMap[groupUUID].SpaceRole
+				journal.record(fmt.Sprintf("removal of group %s from space %s", groupUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.AddGroupToSpace(ctx, projectUUID, spaceUUID, groupUUID, previousRole)
+				})
 			}
 			delete(currentGroupMap, groupUUID) // Remove from map to avoid re-processing
 		}
@@ -601,6 +786,15 @@ func (c *SpaceController) manageSpaceGroupAccess(
 			err := c.spaceService.UpdateGroupAccessInSpace(ctx, projectUUID, spaceUUID, groupUUID, newGroup.SpaceRole)
 			if err != nil {
 				errors = append(errors, fmt.Errorf("failed to update group %s access in space %s: %w", groupUUID, spaceUUID, err))
+			} else if exists {
+				previousRole := currentGroup.SpaceRole
+				journal.record(fmt.Sprintf("role change of group %s in space %s", groupUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.UpdateGroupAccessInSpace(ctx, projectUUID, spaceUUID, groupUUID, previousRole)
+				})
+			} else {
+				journal.record(fmt.Sprintf("access of group %s to space %s", groupUUID, spaceUUID), func(ctx context.Context) error {
+					return c.spaceService.RemoveGroupFromSpace(ctx, projectUUID, spaceUUID, groupUUID)
+				})
 			}
 		}
 	}
@@ -619,6 +813,7 @@ func (c *SpaceController) updateRootSpace(
 	newMemberAccess []models.SpaceAccessMember,
 	newGroupAccess []models.SpaceAccessGroup,
 	currentSpaceDetails *models.SpaceDetails,
+	journal *spaceJournal,
 ) []error {
 	var errors []error
 
@@ -640,6 +835,7 @@ func (c *SpaceController) updateRootSpace(
 		if err != nil {
 			return []error{fmt.Errorf("failed to update space properties: %w", err)}
 		}
+		c.recordSpacePropertiesUpdate(journal, projectUUID, currentSpaceDetails, inheritForUpdate, true)
 		eff := models.EffectiveInheritFromOptional(updatedSpaceDetails.InheritParentPermissions, updatedSpaceDetails.IsPrivate)
 		tflog.Debug(ctx, "(SpaceController.updateRootSpace) Updated space details", map[string]interface{}{
 			"projectUUID":              updatedSpaceDetails.ProjectUUID,
@@ -658,6 +854,7 @@ func (c *SpaceController) updateRootSpace(
 		spaceUUID,
 		newMemberAccess,
 		currentSpaceDetails.SpaceAccessMembers,
+		journal,
 	)
 	errors = append(errors, memberErrors...)
 
@@ -668,6 +865,7 @@ func (c *SpaceController) updateRootSpace(
 		spaceUUID,
 		newGroupAccess,
 		currentSpaceDetails.SpaceAccessGroups,
+		journal,
 	)
 	errors = append(errors, groupErrors...)
 
@@ -679,6 +877,7 @@ func (c *SpaceController) updateNestedSpace(
 	ctx context.Context,
 	options UpdateSpaceOptions,
 	currentSpaceDetails *models.SpaceDetails,
+	journal *spaceJournal,
 ) []error {
 	var errors []error
 
@@ -693,6 +892,7 @@ func (c *SpaceController) updateNestedSpace(
 		if err != nil {
 			return []error{fmt.Errorf("failed to move space to new parent: %w", err)}
 		}
+		c.recordSpaceMove(journal, options.ProjectUUID, options.SpaceUUID, currentSpaceDetails.ParentSpaceUUID)
 	}
 
 	// 2. Update the space properties via the service layer if they have changed
@@ -703,6 +903,7 @@ func (c *SpaceController) updateNestedSpace(
 		if err != nil {
 			return []error{fmt.Errorf("failed to update nested space properties: %w", err)}
 		}
+		c.recordSpacePropertiesUpdate(journal, options.ProjectUUID, currentSpaceDetails, inheritForUpdate, false)
 	}
 
 	// 3. Manage member access
@@ -712,6 +913,7 @@ func (c *SpaceController) updateNestedSpace(
 		options.SpaceUUID,
 		options.MemberAccess,
 		currentSpaceDetails.SpaceAccessMembers,
+		journal,
 	)
 	errors = append(errors, memberErrors...)
 
@@ -722,6 +924,7 @@ func (c *SpaceController) updateNestedSpace(
 		options.SpaceUUID,
 		options.GroupAccess,
 		currentSpaceDetails.SpaceAccessGroups,
+		journal,
 	)
 	errors = append(errors, groupErrors...)
 
@@ -733,6 +936,8 @@ func (c *SpaceController) updateNestedSpace(
 func (c *SpaceController) moveRootToNestedSpace(
 	ctx context.Context,
 	options UpdateSpaceOptions,
+	originalSpaceDetails *models.SpaceDetails,
+	journal *spaceJournal,
 ) []error {
 	tflog.Debug(ctx, "(SpaceController.moveRootToNestedSpace) Moving root space to nested space", map[string]interface{}{
 		"options": options,
@@ -743,6 +948,7 @@ func (c *SpaceController) moveRootToNestedSpace(
 	if err != nil {
 		return []error{fmt.Errorf("failed to move root space %s to nested space under parent %s: %w", options.SpaceUUID, *options.ParentSpaceUUID, err)}
 	}
+	c.recordSpaceMove(journal, options.ProjectUUID, options.SpaceUUID, originalSpaceDetails.ParentSpaceUUID)
 
 	// 2. Get the current space details to check its properties
 	currentSpaceDetails, err := c.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
@@ -758,6 +964,10 @@ func (c *SpaceController) moveRootToNestedSpace(
 		if err != nil {
 			return []error{fmt.Errorf("failed to update space properties after moving to nested: %w", err)}
 		}
+		c.recordSpacePropertiesUpdate(journal, options.ProjectUUID, currentSpaceDetails, inheritForUpdate, false)
+	}
+	if options.LeaveAccessUnchanged {
+		return nil
 	}
 
 	// 4. Manage member access
@@ -767,6 +977,7 @@ func (c *SpaceController) moveRootToNestedSpace(
 		options.SpaceUUID,
 		options.MemberAccess,
 		currentSpaceDetails.SpaceAccessMembers,
+		journal,
 	)
 
 	// 5. Manage group access
@@ -776,6 +987,7 @@ func (c *SpaceController) moveRootToNestedSpace(
 		options.SpaceUUID,
 		options.GroupAccess,
 		currentSpaceDetails.SpaceAccessGroups,
+		journal,
 	)
 
 	var errors []error
@@ -790,6 +1002,8 @@ func (c *SpaceController) moveRootToNestedSpace(
 func (c *SpaceController) moveNestedToRootSpace(
 	ctx context.Context,
 	options UpdateSpaceOptions,
+	originalSpaceDetails *models.SpaceDetails,
+	journal *spaceJournal,
 ) []error {
 	tflog.Debug(ctx, "(SpaceController.moveNestedToRootSpace) Moving nested space to root space", map[string]interface{}{
 		"options": options,
@@ -800,6 +1014,7 @@ func (c *SpaceController) moveNestedToRootSpace(
 	if err1 != nil {
 		return []error{fmt.Errorf("failed to move nested space %s to root: %w", options.SpaceUUID, err1)}
 	}
+	c.recordSpaceMove(journal, options.ProjectUUID, options.SpaceUUID, originalSpaceDetails.ParentSpaceUUID)
 
 	// 2. Get current space details after the move, then patch name / visibility only when needed
 	currentSpaceDetails, err := c.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
@@ -814,6 +1029,11 @@ func (c *SpaceController) moveNestedToRootSpace(
 		if err2 != nil {
 			return []error{fmt.Errorf("failed to update space properties after moving to root: %w", err2)}
 		}
+		c.recordSpacePropertiesUpdate(journal, options.ProjectUUID, currentSpaceDetails, inheritForUpdate, true)
+	}
+
+	if options.LeaveAccessUnchanged {
+		return nil
 	}
 
 	// 3. Refresh space details for access management
@@ -829,6 +1049,7 @@ func (c *SpaceController) moveNestedToRootSpace(
 		options.SpaceUUID,
 		options.MemberAccess,
 		currentSpaceDetails.SpaceAccessMembers,
+		journal,
 	)
 
 	groupErrors := c.manageSpaceGroupAccess(
@@ -837,8 +1058,123 @@ func (c *SpaceController) moveNestedToRootSpace(
 		options.SpaceUUID,
 		options.GroupAccess,
 		currentSpaceDetails.SpaceAccessGroups,
+		journal,
 	)
 	accessErrors = append(accessErrors, groupErrors...)
 
 	return accessErrors
 }
+
+// getSpaceContentTree builds the content tree of a space, following nested spaces up to maxSpaceTreeDepth.
+func (c *SpaceController) getSpaceContentTree(ctx context.Context, projectUUID, spaceUUID string, depth int) (*models.SpaceContentTree, error) {
+	if depth > maxSpaceTreeDepth {
+		return nil, fmt.Errorf("space tree exceeded max depth %d", maxSpaceTreeDepth)
+	}
+	space, err := c.spaceService.GetSpace(ctx, projectUUID, spaceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get space %s: %w", spaceUUID, err)
+	}
+
+	tree := &models.SpaceContentTree{
+		SpaceUUID:   space.SpaceUUID,
+		SpaceName:   space.SpaceName,
+		Contents:    []models.SpaceContent{},
+		ChildSpaces: []models.SpaceContentTree{},
+	}
+	for _, chart := range space.Queries {
+		tree.Contents = append(tree.Contents, models.SpaceContent{UUID: chart.UUID, Name: chart.Name, ContentType: models.CONTENT_TYPE_CHART})
+	}
+	for _, dashboard := range space.Dashboards {
+		tree.Contents = append(tree.Contents, models.SpaceContent{UUID: dashboard.UUID, Name: dashboard.Name, ContentType: models.CONTENT_TYPE_DASHBOARD})
+	}
+	sqlCharts, err := c.spaceService.ListSpaceSQLCharts(ctx, projectUUID, spaceUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SQL charts of space %s: %w", spaceUUID, err)
+	}
+	tree.Contents = append(tree.Contents, sqlCharts...)
+	for _, child := range space.ChildSpaces {
+		childTree, err := c.getSpaceContentTree(ctx, projectUUID, child.SpaceUUID, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		tree.ChildSpaces = append(tree.ChildSpaces, *childTree)
+	}
+	return tree, nil
+}
+
+// deleteSpaceTree deletes the nested spaces of a tree depth-first, then the space itself.
+func (c *SpaceController) deleteSpaceTree(ctx context.Context, projectUUID string, tree models.SpaceContentTree) error {
+	for _, child := range tree.ChildSpaces {
+		if err := c.deleteSpaceTree(ctx, projectUUID, child); err != nil {
+			return err
+		}
+	}
+	tflog.Debug(ctx, "(SpaceController.deleteSpaceTree) Deleting nested space", map[string]interface{}{
+		"spaceUUID": tree.SpaceUUID,
+		"spaceName": tree.SpaceName,
+	})
+	if err := c.spaceService.DeleteSpace(ctx, projectUUID, tree.SpaceUUID); err != nil {
+		return fmt.Errorf("failed to delete nested space %s: %w", tree.SpaceUUID, err)
+	}
+	return nil
+}
+
+// relocateSpaceContents moves the direct child spaces, charts, SQL charts and dashboards of a space
+// to the fallback space, or to the parent space when no fallback is set.
+// Child spaces of a root space become root spaces; charts and dashboards always need a target space.
+func (c *SpaceController) relocateSpaceContents(ctx context.Context, options DeleteSpaceOptions) error {
+	space, err := c.spaceService.GetSpace(ctx, options.ProjectUUID, options.SpaceUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get space details: %w", err)
+	}
+
+	target := space.ParentSpaceUUID
+	if !models.IsEmptyStringPointer(options.FallbackSpaceUUID) {
+		target = options.FallbackSpaceUUID
+		if *target == options.SpaceUUID {
+			return fmt.Errorf("cannot relocate contents of space %s into itself", options.SpaceUUID)
+		}
+		for _, child := range space.ChildSpaces {
+			tree, err := c.GetSpaceContentTree(ctx, options.ProjectUUID, child.SpaceUUID)
+			if err != nil {
+				return fmt.Errorf("failed to list space contents: %w", err)
+			}
+			if tree.Contains(*target) {
+				return fmt.Errorf("cannot relocate contents of space %s: fallback space %s is nested in it", options.SpaceUUID, *target)
+			}
+		}
+	} else if models.IsEmptyStringPointer(target) {
+		target = nil
+	}
+
+	sqlCharts, err := c.spaceService.ListSpaceSQLCharts(ctx, options.ProjectUUID, options.SpaceUUID)
+	if err != nil {
+		return fmt.Errorf("failed to list SQL charts of space %s: %w", options.SpaceUUID, err)
+	}
+	hasContent := len(space.Queries) > 0 || len(space.Dashboards) > 0 || len(sqlCharts) > 0
+	if target == nil && hasContent {
+		return fmt.Errorf("cannot relocate charts and dashboards of root space %s: set a fallback space", options.SpaceUUID)
+	}
+
+	for _, child := range space.ChildSpaces {
+		if err := c.spaceService.MoveSpace(ctx, options.ProjectUUID, child.SpaceUUID, target); err != nil {
+			return fmt.Errorf("failed to relocate child space %s: %w", child.SpaceUUID, err)
+		}
+	}
+	for _, chart := range space.Queries {
+		if err := c.spaceService.MoveContent(ctx, options.ProjectUUID, models.CONTENT_TYPE_CHART, chart.UUID, *target); err != nil {
+			return fmt.Errorf("failed to relocate chart %s: %w", chart.UUID, err)
+		}
+	}
+	for _, chart := range sqlCharts {
+		if err := c.spaceService.MoveSQLChart(ctx, options.ProjectUUID, chart.UUID, *target); err != nil {
+			return fmt.Errorf("failed to relocate SQL chart %s: %w", chart.UUID, err)
+		}
+	}
+	for _, dashboard := range space.Dashboards {
+		if err := c.spaceService.MoveContent(ctx, options.ProjectUUID, models.CONTENT_TYPE_DASHBOARD, dashboard.UUID, *target); err != nil {
+			return fmt.Errorf("failed to relocate dashboard %s: %w", dashboard.UUID, err)
+		}
+	}
+	return nil
+}
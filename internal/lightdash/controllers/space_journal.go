@@ -0,0 +1,142 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// spaceJournal records the completed steps of a space create or update, so that they can be
+// undone in reverse order when a later step fails. A journal belongs to a single operation.
+type spaceJournal struct {
+	entries []spaceJournalEntry
+}
+
+type spaceJournalEntry struct {
+	description string
+	undo        func(ctx context.Context) error
+}
+
+func newSpaceJournal() *spaceJournal {
+	return &spaceJournal{}
+}
+
+// record adds a completed step together with the call that reverts it.
+func (j *spaceJournal) record(description string, undo func(ctx context.Context) error) {
+	j.entries = append(j.entries, spaceJournalEntry{description: description, undo: undo})
+}
+
+// steps returns the descriptions of the completed steps in the order they were applied.
+func (j *spaceJournal) steps() []string {
+	steps := make([]string, 0, len(j.entries))
+	for _, entry := range j.entries {
+		steps = append(steps, entry.description)
+	}
+	return steps
+}
+
+// rollback undoes the completed steps in reverse order. It keeps going when a step cannot be
+// undone and returns one error per failed step. The journal is empty afterwards.
+func (j *spaceJournal) rollback(ctx context.Context) []error {
+	var rollbackErrors []error
+	for i := len(j.entries) - 1; i >= 0; i-- {
+		entry := j.entries[i]
+		tflog.Debug(ctx, "(spaceJournal.rollback) Undoing step", map[string]interface{}{
+			"step": entry.description,
+		})
+		if err := entry.undo(ctx); err != nil {
+			rollbackErrors = append(rollbackErrors, fmt.Errorf("failed to roll back %s: %w", entry.description, err))
+		}
+	}
+	j.entries = nil
+	return rollbackErrors
+}
+
+// recordSpaceCreation records a created space, which is undone by deleting it.
+func (c *SpaceController) recordSpaceCreation(journal *spaceJournal, projectUUID, spaceUUID string) {
+	journal.record(fmt.Sprintf("creation of space %s", spaceUUID), func(ctx context.Context) error {
+		return c.spaceService.DeleteSpace(ctx, projectUUID, spaceUUID)
+	})
+}
+
+// recordSpaceMove records a moved space, which is undone by moving it back under its previous parent.
+func (c *SpaceController) recordSpaceMove(journal *spaceJournal, projectUUID, spaceUUID string, previousParentSpaceUUID *string) {
+	journal.record(fmt.Sprintf("move of space %s", spaceUUID), func(ctx context.Context) error {
+		return c.spaceService.MoveSpace(ctx, projectUUID, spaceUUID, previousParentSpaceUUID)
+	})
+}
+
+// recordSpacePropertiesUpdate records a changed name or visibility, which is undone by restoring the
+// previous values. The visibility is only restored when the update changed it.
+func (c *SpaceController) recordSpacePropertiesUpdate(
+	journal *spaceJournal,
+	projectUUID string,
+	previous *models.SpaceDetails,
+	inheritForUpdate *bool,
+	isRootSpace bool,
+) {
+	spaceUUID := previous.SpaceUUID
+	previousName := previous.SpaceName
+	var previousInherit *bool
+	if inheritForUpdate != nil {
+		inherit := previous.InheritParentPermissions
+		previousInherit = &inherit
+	}
+	journal.record(fmt.Sprintf("update of space %s properties", spaceUUID), func(ctx context.Context) error {
+		if isRootSpace {
+			_, err := c.spaceService.UpdateRootSpace(ctx, projectUUID, spaceUUID, previousName, previousInherit)
+			return err
+		}
+		_, err := c.spaceService.UpdateNestedSpace(ctx, projectUUID, spaceUUID, previousName, previousInherit)
+		return err
+	})
+}
+
+// rollbackSpaceOperation undoes the completed steps of a failed create or update and returns the
+// space as it exists afterwards, so that callers can persist the real state. The result is nil
+// when the space does not exist, e.g. when a created space was deleted by the rollback.
+func (c *SpaceController) rollbackSpaceOperation(
+	ctx context.Context,
+	journal *spaceJournal,
+	projectUUID string,
+	spaceDetails *models.SpaceDetails,
+	operationErrors []error,
+) (*models.SpaceDetails, []error) {
+	tflog.Debug(ctx, "(SpaceController.rollbackSpaceOperation) Rolling back space operation", map[string]interface{}{
+		"steps":  journal.steps(),
+		"errors": operationErrors,
+	})
+
+	operationErrors = append(operationErrors, journal.rollback(ctx)...)
+	if spaceDetails == nil {
+		return nil, operationErrors
+	}
+
+	liveSpaceDetails, err := c.GetSpace(ctx, projectUUID, spaceDetails.SpaceUUID)
+	if err != nil {
+		if errors.Is(err, services.ErrSpaceNotFound) {
+			return nil, operationErrors
+		}
+		// Fall back to the last known details when the space cannot be read
+		return spaceDetails, append(operationErrors, err)
+	}
+	return liveSpaceDetails, operationErrors
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSpaceJournalRollback(t *testing.T) {
+	journal := newSpaceJournal()
+	undone := []string{}
+	for _, step := range []string{"creation", "member", "group"} {
+		journal.record(step, func(ctx context.Context) error {
+			undone = append(undone, step)
+			if step == "member" {
+				return errors.New("unexpected status code: 500")
+			}
+			return nil
+		})
+	}
+
+	if got := journal.steps(); !reflect.DeepEqual(got, []string{"creation", "member", "group"}) {
+		t.Errorf("unexpected steps: %v", got)
+	}
+
+	errs := journal.rollback(context.Background())
+	if !reflect.DeepEqual(undone, []string{"group", "member", "creation"}) {
+		t.Errorf("expected the steps to be undone in reverse order, got %v", undone)
+	}
+	if len(errs) != 1 || errs[0].Error() != "failed to roll back member: unexpected status code: 500" {
+		t.Errorf("unexpected rollback errors: %v", errs)
+	}
+	if len(journal.steps()) != 0 {
+		t.Errorf("expected the journal to be empty after the rollback")
+	}
+}
+
+func TestSpaceJournalRollbackEmpty(t *testing.T) {
+	if errs := newSpaceJournal().rollback(context.Background()); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
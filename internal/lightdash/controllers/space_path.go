@@ -0,0 +1,179 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+var ErrSpacePathNotFound = errors.New("space path not found")
+
+// spaceCandidate is a space that may match a name in a path
+type spaceCandidate struct {
+	SpaceUUID string
+	Name      string
+}
+
+// ResolvedSpacePath is the result of walking a space path.
+// SpaceUUIDs holds the UUIDs of the spaces found along the path, in order;
+// it is shorter than Names when the path only partially exists.
+type ResolvedSpacePath struct {
+	Names      []string
+	SpaceUUIDs []string
+}
+
+// IsComplete returns true if every space of the path exists
+func (p *ResolvedSpacePath) IsComplete() bool {
+	return len(p.SpaceUUIDs) == len(p.Names)
+}
+
+// SpaceUUID returns the UUID of the last space of a complete path
+func (p *ResolvedSpacePath) SpaceUUID() string {
+	if !p.IsComplete() || len(p.SpaceUUIDs) == 0 {
+		return ""
+	}
+	return p.SpaceUUIDs[len(p.SpaceUUIDs)-1]
+}
+
+// ResolveSpacePath walks a slash-separated space path from the root spaces of a project down
+// through the child spaces. It stops at the first name that does not exist and fails when a
+// name matches more than one space at the same level.
+func (c *SpaceController) ResolveSpacePath(ctx context.Context, projectUUID, path string) (*ResolvedSpacePath, error) {
+	names, err := models.ParseSpacePath(path)
+	if err != nil {
+		return nil, err
+	}
+	resolved := &ResolvedSpacePath{Names: names, SpaceUUIDs: []string{}}
+
+	spaces, err := c.spaceService.ListSpaces(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []spaceCandidate{}
+	for _, space := range spaces {
+		if models.IsEmptyStringPointer(space.ParentSpaceUUID) {
+			candidates = append(candidates, spaceCandidate{SpaceUUID: space.SpaceUUID, Name: space.SpaceName})
+		}
+	}
+
+	for i, name := range names {
+		spaceUUID, err := matchSpaceName(candidates, name, models.FormatSpacePath(names[:i+1]))
+		if err != nil {
+			return nil, err
+		}
+		if spaceUUID == "" {
+			break
+		}
+		resolved.SpaceUUIDs = append(resolved.SpaceUUIDs, spaceUUID)
+		if i == len(names)-1 {
+			break
+		}
+
+		space, err := c.spaceService.GetSpace(ctx, projectUUID, spaceUUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get space %s: %w", spaceUUID, err)
+		}
+		candidates = []spaceCandidate{}
+		for _, child := range space.ChildSpaces {
+			candidates = append(candidates, spaceCandidate{SpaceUUID: child.SpaceUUID, Name: child.Name})
+		}
+	}
+
+	tflog.Debug(ctx, "(SpaceController.ResolveSpacePath) Resolved space path", map[string]interface{}{
+		"path":       path,
+		"spaceUUIDs": resolved.SpaceUUIDs,
+	})
+	return resolved, nil
+}
+
+// FindSpaceByPath returns the UUID of the space at the given path.
+func (c *SpaceController) FindSpaceByPath(ctx context.Context, projectUUID, path string) (string, error) {
+	resolved, err := c.ResolveSpacePath(ctx, projectUUID, path)
+	if err != nil {
+		return "", err
+	}
+	if !resolved.IsComplete() {
+		missing := models.FormatSpacePath(resolved.Names[:len(resolved.SpaceUUIDs)+1])
+		return "", fmt.Errorf("%w: no space %q in project %s", ErrSpacePathNotFound, missing, projectUUID)
+	}
+	return resolved.SpaceUUID(), nil
+}
+
+// EnsureSpacePathOptions contains all the options for ensuring a space path exists
+type EnsureSpacePathOptions struct {
+	ProjectUUID string
+	Path        string
+	// IsPrivate is applied to the spaces that have to be created
+	IsPrivate *bool
+}
+
+// EnsureSpacePath creates the missing spaces of a path, parent first.
+// It returns the resolved path and the UUIDs of the spaces it created.
+func (c *SpaceController) EnsureSpacePath(ctx context.Context, options EnsureSpacePathOptions) (*ResolvedSpacePath, []string, error) {
+	resolved, err := c.ResolveSpacePath(ctx, options.ProjectUUID, options.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := []string{}
+	for i := len(resolved.SpaceUUIDs); i < len(resolved.Names); i++ {
+		var parentSpaceUUID *string
+		if i > 0 {
+			parentSpaceUUID = &resolved.SpaceUUIDs[i-1]
+		}
+		space, errs := c.CreateSpace(ctx, CreateSpaceOptions{
+			ProjectUUID:     options.ProjectUUID,
+			SpaceName:       resolved.Names[i],
+			IsPrivate:       options.IsPrivate,
+			ParentSpaceUUID: parentSpaceUUID,
+		})
+		if len(errs) > 0 {
+			// A space that could not be rolled back is reported as created so that it is not orphaned
+			if space != nil {
+				created = append(created, space.SpaceUUID)
+			}
+			return resolved, created, fmt.Errorf("failed to create space %q: %w", models.FormatSpacePath(resolved.Names[:i+1]), errors.Join(errs...))
+		}
+		resolved.SpaceUUIDs = append(resolved.SpaceUUIDs, space.SpaceUUID)
+		created = append(created, space.SpaceUUID)
+	}
+	return resolved, created, nil
+}
+
+// matchSpaceName returns the UUID of the only candidate with the given name,
+// or an empty string when there is none.
+func matchSpaceName(candidates []spaceCandidate, name, path string) (string, error) {
+	matches := []string{}
+	for _, candidate := range candidates {
+		if candidate.Name == name {
+			matches = append(matches, candidate.SpaceUUID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", nil
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("space path %q is ambiguous: %d spaces have this name (%s)", path, len(matches), strings.Join(matches, ", "))
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"testing"
+)
+
+func TestMatchSpaceName(t *testing.T) {
+	candidates := []spaceCandidate{
+		{SpaceUUID: "uuid-1", Name: "Marketing"},
+		{SpaceUUID: "uuid-2", Name: "Archive"},
+		{SpaceUUID: "uuid-3", Name: "Archive"},
+	}
+
+	tests := []struct {
+		name        string
+		expected    string
+		expectError bool
+	}{
+		{"Marketing", "uuid-1", false},
+		{"marketing", "", false},
+		{"Sales", "", false},
+		{"Archive", "", true},
+	}
+
+	for _, test := range tests {
+		got, err := matchSpaceName(candidates, test.name, "Root/"+test.name)
+		if (err != nil) != test.expectError {
+			t.Errorf("matchSpaceName(%q) error = %v, expectError %v", test.name, err, test.expectError)
+		}
+		if got != test.expected {
+			t.Errorf("matchSpaceName(%q) = %q, expected %q", test.name, got, test.expected)
+		}
+	}
+}
+
+func TestResolvedSpacePath(t *testing.T) {
+	partial := ResolvedSpacePath{Names: []string{"A", "B"}, SpaceUUIDs: []string{"uuid-a"}}
+	if partial.IsComplete() || partial.SpaceUUID() != "" {
+		t.Errorf("expected a partial path without a space UUID")
+	}
+	complete := ResolvedSpacePath{Names: []string{"A", "B"}, SpaceUUIDs: []string{"uuid-a", "uuid-b"}}
+	if !complete.IsComplete() || complete.SpaceUUID() != "uuid-b" {
+		t.Errorf("expected a complete path ending at uuid-b, got %q", complete.SpaceUUID())
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// ResolveSpaceSlugs maps the space slugs used by `lightdash download` to space UUIDs.
+// Every space is keyed by the slugs of its ancestors and its own slug joined with "/",
+// and also by its own slug when no other space of the project has the same slug.
+func (c *SpaceController) ResolveSpaceSlugs(ctx context.Context, projectUUID string) (map[string]string, error) {
+	spaces, err := c.spaceService.ListSpaces(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	slugs := indexSpaceSlugs(spaces)
+
+	tflog.Debug(ctx, "(SpaceController.ResolveSpaceSlugs) Resolved space slugs", map[string]interface{}{
+		"projectUUID": projectUUID,
+		"count":       len(slugs),
+	})
+	return slugs, nil
+}
+
+// indexSpaceSlugs keys the spaces by their slug path and by their own unique slug.
+// Spaces without a slug are left out.
+func indexSpaceSlugs(spaces []apiv1.ListSpacesInProjectV1Results) map[string]string {
+	byUUID := make(map[string]apiv1.ListSpacesInProjectV1Results, len(spaces))
+	slugCounts := map[string]int{}
+	for _, space := range spaces {
+		byUUID[space.SpaceUUID] = space
+		slugCounts[space.Slug]++
+	}
+
+	slugs := map[string]string{}
+	for _, space := range spaces {
+		if space.Slug == "" {
+			continue
+		}
+		if slugCounts[space.Slug] == 1 {
+			slugs[space.Slug] = space.SpaceUUID
+		}
+
+		path := []string{space.Slug}
+		parent := space.ParentSpaceUUID
+		for depth := 0; !models.IsEmptyStringPointer(parent) && depth < maxSpaceTreeDepth; depth++ {
+			parentSpace, ok := byUUID[*parent]
+			if !ok {
+				break
+			}
+			path = append([]string{parentSpace.Slug}, path...)
+			parent = parentSpace.ParentSpaceUUID
+		}
+		slugs[strings.Join(path, "/")] = space.SpaceUUID
+	}
+	return slugs
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestIndexSpaceSlugs(t *testing.T) {
+	sales := "sales-uuid"
+	marketing := "marketing-uuid"
+	spaces := []apiv1.ListSpacesInProjectV1Results{
+		{SpaceUUID: sales, Slug: "sales"},
+		{SpaceUUID: marketing, Slug: "marketing"},
+		{SpaceUUID: "sales-weekly-uuid", Slug: "weekly", ParentSpaceUUID: &sales},
+		{SpaceUUID: "marketing-weekly-uuid", Slug: "weekly", ParentSpaceUUID: &marketing},
+		{SpaceUUID: "legacy-uuid"},
+	}
+
+	expected := map[string]string{
+		"sales":            sales,
+		"marketing":        marketing,
+		"sales/weekly":     "sales-weekly-uuid",
+		"marketing/weekly": "marketing-weekly-uuid",
+	}
+	if got := indexSpaceSlugs(spaces); !reflect.DeepEqual(got, expected) {
+		t.Errorf("indexSpaceSlugs() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestSpaceAccessValidationError(t *testing.T) {
+	cause := errors.New("not found")
+	var err error = &SpaceAccessValidationError{
+		GroupUUID: "group-1",
+		Err:       fmt.Errorf("group group-1 not found: %w", cause),
+	}
+
+	if err.Error() != "group group-1 not found: not found" {
+		t.Errorf("unexpected message: %s", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("expected the error to wrap its cause")
+	}
+
+	var accessErr *SpaceAccessValidationError
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &accessErr) || accessErr.GroupUUID != "group-1" {
+		t.Errorf("expected to find the validation error in the chain")
+	}
+}
+
+func TestPlannedSpaceDetails(t *testing.T) {
+	yes, no := true, false
+	parent := "parent-1"
+	current := &models.SpaceDetails{
+		ProjectUUID: "project-1",
+		SpaceUUID:   "space-1",
+		SpaceName:   "Old name",
+		IsPrivate:   false,
+		SpaceAccessMembers: []models.SpaceMemberAccess{
+			{UserUUID: "removed", SpaceRole: models.SPACE_EDITOR_ROLE, HasDirectAccess: &yes},
+			{UserUUID: "inherited", SpaceRole: models.SPACE_VIEWER_ROLE, HasDirectAccess: &no},
+		},
+		SpaceAccessGroups: []models.SpaceAccessGroup{{GroupUUID: "removed", SpaceRole: models.SPACE_VIEWER_ROLE}},
+	}
+
+	planned := plannedSpaceDetails(current, UpdateSpaceOptions{
+		SpaceName:       "New name",
+		IsPrivate:       &yes,
+		ParentSpaceUUID: &parent,
+		MemberAccess:    []models.SpaceAccessMember{{UserUUID: "added", SpaceRole: models.SPACE_ADMIN_ROLE}},
+		GroupAccess:     []models.SpaceAccessGroup{{GroupUUID: "added", SpaceRole: models.SPACE_EDITOR_ROLE}},
+	})
+
+	if planned.SpaceUUID != "space-1" || planned.SpaceName != "New name" || !planned.IsPrivate || *planned.ParentSpaceUUID != parent {
+		t.Errorf("unexpected planned details: %+v", planned)
+	}
+	if len(planned.SpaceAccessMembers) != 2 ||
+		planned.SpaceAccessMembers[0].UserUUID != "inherited" ||
+		planned.SpaceAccessMembers[1].UserUUID != "added" ||
+		!planned.SpaceAccessMembers[1].HasDirectSpaceMemberAccess() {
+		t.Errorf("unexpected planned members: %+v", planned.SpaceAccessMembers)
+	}
+	if len(planned.SpaceAccessGroups) != 1 || planned.SpaceAccessGroups[0].GroupUUID != "added" {
+		t.Errorf("unexpected planned groups: %+v", planned.SpaceAccessGroups)
+	}
+	if current.SpaceName != "Old name" || len(current.SpaceAccessMembers) != 2 {
+		t.Errorf("expected the current details to be left unchanged")
+	}
+}
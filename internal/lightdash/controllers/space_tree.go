@@ -0,0 +1,346 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// SpaceTreeNode is a space in a declaratively managed hierarchy.
+// Key identifies the node across applies, so a node keeps its space when it is renamed or moved.
+type SpaceTreeNode struct {
+	Key          string
+	Name         string
+	IsPrivate    *bool
+	MemberAccess []models.SpaceAccessMember
+	GroupAccess  []models.SpaceAccessGroup
+	Children     []SpaceTreeNode
+}
+
+// SpaceTreeLiveSpace is the current name, position, visibility and access of a managed space in Lightdash
+type SpaceTreeLiveSpace struct {
+	Name                     string
+	ParentSpaceUUID          *string
+	InheritParentPermissions bool
+	// MemberAccess only holds the members with direct access to the space
+	MemberAccess []models.SpaceAccessMember
+	GroupAccess  []models.SpaceAccessGroup
+}
+
+type SpaceTreeOperationType string
+
+// List of SpaceTreeOperationType
+const (
+	SPACE_TREE_OPERATION_CREATE SpaceTreeOperationType = "create"
+	SPACE_TREE_OPERATION_MOVE   SpaceTreeOperationType = "move"
+	SPACE_TREE_OPERATION_UPDATE SpaceTreeOperationType = "update"
+	SPACE_TREE_OPERATION_DELETE SpaceTreeOperationType = "delete"
+)
+
+// SpaceTreeOperation is a single step of a space tree plan.
+// ParentKey is empty for top-level nodes, which live under the tree's parent space.
+type SpaceTreeOperation struct {
+	Type      SpaceTreeOperationType
+	Key       string
+	ParentKey string
+	Node      *SpaceTreeNode
+}
+
+// ApplySpaceTreeOptions contains all the options for applying a space tree
+type ApplySpaceTreeOptions struct {
+	ProjectUUID string
+	// ParentSpaceUUID is the space holding the top-level nodes; nil makes them root spaces
+	ParentSpaceUUID *string
+	Desired         []SpaceTreeNode
+	// Previous is the tree applied last time, used to delete removed nodes children-first
+	Previous []SpaceTreeNode
+	// SpaceUUIDs maps node keys to the spaces created for them
+	SpaceUUIDs         map[string]string
+	DeletionProtection bool
+}
+
+// GetSpaceTreeLiveSpaces fetches the current name, parent, visibility and access of each managed space.
+// Spaces that no longer exist are left out of the result.
+func (c *SpaceController) GetSpaceTreeLiveSpaces(ctx context.Context, projectUUID string, spaceUUIDs map[string]string) (map[string]SpaceTreeLiveSpace, error) {
+	live := map[string]SpaceTreeLiveSpace{}
+	for key, spaceUUID := range spaceUUIDs {
+		space, err := c.spaceService.GetSpace(ctx, projectUUID, spaceUUID)
+		if err != nil {
+			if errors.Is(err, services.ErrSpaceNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get space %s for node %q: %w", spaceUUID, key, err)
+		}
+		parent := space.ParentSpaceUUID
+		if models.IsEmptyStringPointer(parent) {
+			parent = nil
+		}
+		liveSpace := SpaceTreeLiveSpace{
+			Name:                     space.SpaceName,
+			ParentSpaceUUID:          parent,
+			InheritParentPermissions: models.EffectiveInheritFromOptional(space.InheritParentPermissions, space.IsPrivate),
+			MemberAccess:             []models.SpaceAccessMember{},
+			GroupAccess:              []models.SpaceAccessGroup{},
+		}
+		for _, member := range space.SpaceAccessMembers {
+			if member.HasDirectAccess {
+				liveSpace.MemberAccess = append(liveSpace.MemberAccess, models.SpaceAccessMember{UserUUID: member.UserUUID, SpaceRole: member.SpaceRole})
+			}
+		}
+		for _, group := range space.SpaceAccessGroups {
+			liveSpace.GroupAccess = append(liveSpace.GroupAccess, models.SpaceAccessGroup{GroupUUID: group.GroupUUID, SpaceRole: group.SpaceRole})
+		}
+		live[key] = liveSpace
+	}
+	return live, nil
+}
+
+// PlanSpaceTree computes the ordered operations that turn the live spaces into the desired tree.
+// A node is updated when its live name, visibility or access differs from the desired node, so
+// changes made in Lightdash are reverted.
+// Creates, moves and updates come parent-first in tree order, so a parent always exists before
+// its children are placed in it. Deletes come last and children-first, after surviving
+// children have been moved out of the spaces being deleted.
+func PlanSpaceTree(
+	desired []SpaceTreeNode,
+	previous []SpaceTreeNode,
+	spaceUUIDs map[string]string,
+	live map[string]SpaceTreeLiveSpace,
+	parentSpaceUUID *string,
+) []SpaceTreeOperation {
+	previousDepths := map[string]int{}
+	walkSpaceTree(previous, "", 0, func(node SpaceTreeNode, _ string, depth int) {
+		previousDepths[node.Key] = depth
+	})
+
+	operations := []SpaceTreeOperation{}
+	desiredKeys := map[string]bool{}
+	walkSpaceTree(desired, "", 0, func(node SpaceTreeNode, parentKey string, _ int) {
+		desiredKeys[node.Key] = true
+		n := node
+		liveSpace, exists := live[node.Key]
+		if _, managed := spaceUUIDs[node.Key]; !managed || !exists {
+			operations = append(operations, SpaceTreeOperation{Type: SPACE_TREE_OPERATION_CREATE, Key: node.Key, ParentKey: parentKey, Node: &n})
+			return
+		}
+
+		// A parent created in this plan has no UUID yet, so the node has to move into it
+		expectedParent := parentSpaceUUID
+		if parentKey != "" {
+			expectedParent = nil
+			if parentUUID, ok := spaceUUIDs[parentKey]; ok {
+				if _, parentExists := live[parentKey]; parentExists {
+					expectedParent = &parentUUID
+				}
+			}
+		}
+		parentKnown := parentKey == "" || expectedParent != nil
+		if !parentKnown || !models.EqualOptionalStrings(liveSpace.ParentSpaceUUID, expectedParent) {
+			operations = append(operations, SpaceTreeOperation{Type: SPACE_TREE_OPERATION_MOVE, Key: node.Key, ParentKey: parentKey, Node: &n})
+		}
+
+		if liveSpace.Name != node.Name || !spaceTreeNodeMatchesLive(node, liveSpace) {
+			operations = append(operations, SpaceTreeOperation{Type: SPACE_TREE_OPERATION_UPDATE, Key: node.Key, ParentKey: parentKey, Node: &n})
+		}
+	})
+
+	deletes := []SpaceTreeOperation{}
+	for key := range spaceUUIDs {
+		if desiredKeys[key] {
+			continue
+		}
+		if _, exists := live[key]; !exists {
+			continue
+		}
+		deletes = append(deletes, SpaceTreeOperation{Type: SPACE_TREE_OPERATION_DELETE, Key: key})
+	}
+	sort.Slice(deletes, func(i, j int) bool {
+		di, dj := previousDepths[deletes[i].Key], previousDepths[deletes[j].Key]
+		if di != dj {
+			return di > dj
+		}
+		return deletes[i].Key < deletes[j].Key
+	})
+
+	return append(operations, deletes...)
+}
+
+// ApplySpaceTree plans and applies the desired tree.
+// It returns the node key to space UUID mapping as far as it got, even when an operation fails,
+// so that spaces created before the failure stay tracked.
+func (c *SpaceController) ApplySpaceTree(ctx context.Context, options ApplySpaceTreeOptions) (map[string]string, error) {
+	spaceUUIDs := map[string]string{}
+	for key, spaceUUID := range options.SpaceUUIDs {
+		spaceUUIDs[key] = spaceUUID
+	}
+	if models.IsEmptyStringPointer(options.ParentSpaceUUID) {
+		options.ParentSpaceUUID = nil
+	}
+
+	live, err := c.GetSpaceTreeLiveSpaces(ctx, options.ProjectUUID, spaceUUIDs)
+	if err != nil {
+		return spaceUUIDs, err
+	}
+	operations := PlanSpaceTree(options.Desired, options.Previous, spaceUUIDs, live, options.ParentSpaceUUID)
+	tflog.Debug(ctx, "(SpaceController.ApplySpaceTree) Planned space tree operations", map[string]interface{}{
+		"operations": operations,
+	})
+
+	if options.DeletionProtection {
+		for _, operation := range operations {
+			if operation.Type == SPACE_TREE_OPERATION_DELETE {
+				return spaceUUIDs, fmt.Errorf("cannot delete space %s of node %q: deletion protection is enabled", spaceUUIDs[operation.Key], operation.Key)
+			}
+		}
+	}
+
+	parentOf := func(operation SpaceTreeOperation) *string {
+		if operation.ParentKey == "" {
+			return options.ParentSpaceUUID
+		}
+		parentUUID := spaceUUIDs[operation.ParentKey]
+		return &parentUUID
+	}
+
+	for _, operation := range operations {
+		switch operation.Type {
+		case SPACE_TREE_OPERATION_CREATE:
+			created, errs := c.CreateSpace(ctx, CreateSpaceOptions{
+				ProjectUUID:     options.ProjectUUID,
+				SpaceName:       operation.Node.Name,
+				IsPrivate:       operation.Node.IsPrivate,
+				ParentSpaceUUID: parentOf(operation),
+				MemberAccess:    operation.Node.MemberAccess,
+				GroupAccess:     operation.Node.GroupAccess,
+			})
+			if len(errs) > 0 {
+				// A space that could not be rolled back is tracked so that it is not orphaned
+				if created != nil {
+					spaceUUIDs[operation.Key] = created.SpaceUUID
+				}
+				return spaceUUIDs, fmt.Errorf("failed to create space for node %q: %w", operation.Key, errors.Join(errs...))
+			}
+			spaceUUIDs[operation.Key] = created.SpaceUUID
+		case SPACE_TREE_OPERATION_MOVE:
+			if err := c.spaceService.MoveSpace(ctx, options.ProjectUUID, spaceUUIDs[operation.Key], parentOf(operation)); err != nil {
+				return spaceUUIDs, fmt.Errorf("failed to move space for node %q: %w", operation.Key, err)
+			}
+		case SPACE_TREE_OPERATION_UPDATE:
+			_, errs := c.UpdateSpace(ctx, UpdateSpaceOptions{
+				ProjectUUID:     options.ProjectUUID,
+				SpaceUUID:       spaceUUIDs[operation.Key],
+				SpaceName:       operation.Node.Name,
+				IsPrivate:       operation.Node.IsPrivate,
+				ParentSpaceUUID: parentOf(operation),
+				MemberAccess:    operation.Node.MemberAccess,
+				GroupAccess:     operation.Node.GroupAccess,
+			})
+			if len(errs) > 0 {
+				return spaceUUIDs, fmt.Errorf("failed to update space for node %q: %w", operation.Key, errors.Join(errs...))
+			}
+		case SPACE_TREE_OPERATION_DELETE:
+			err := c.spaceService.DeleteSpace(ctx, options.ProjectUUID, spaceUUIDs[operation.Key])
+			if err != nil {
+				return spaceUUIDs, fmt.Errorf("failed to delete space for node %q: %w", operation.Key, err)
+			}
+			delete(spaceUUIDs, operation.Key)
+		}
+	}
+
+	// Forget spaces that disappeared outside of Terraform and are no longer desired
+	for key := range spaceUUIDs {
+		if _, exists := live[key]; !exists && !spaceTreeContainsKey(options.Desired, key) {
+			delete(spaceUUIDs, key)
+		}
+	}
+	return spaceUUIDs, nil
+}
+
+// DeleteSpaceTree deletes all managed spaces of a tree, children first.
+func (c *SpaceController) DeleteSpaceTree(ctx context.Context, options ApplySpaceTreeOptions) error {
+	if options.DeletionProtection {
+		return fmt.Errorf("cannot delete space tree: deletion protection is enabled")
+	}
+	options.Desired = nil
+	_, err := c.ApplySpaceTree(ctx, options)
+	return err
+}
+
+// walkSpaceTree visits the nodes in pre-order, passing the key of the parent node and the depth.
+func walkSpaceTree(nodes []SpaceTreeNode, parentKey string, depth int, visit func(node SpaceTreeNode, parentKey string, depth int)) {
+	for _, node := range nodes {
+		visit(node, parentKey, depth)
+		walkSpaceTree(node.Children, node.Key, depth+1, visit)
+	}
+}
+
+func spaceTreeContainsKey(nodes []SpaceTreeNode, key string) bool {
+	found := false
+	walkSpaceTree(nodes, "", 0, func(node SpaceTreeNode, _ string, _ int) {
+		if node.Key == key {
+			found = true
+		}
+	})
+	return found
+}
+
+// spaceTreeNodeMatchesLive reports whether the live space has the visibility and access of the node.
+// The visibility is only compared when the node sets it.
+func spaceTreeNodeMatchesLive(node SpaceTreeNode, live SpaceTreeLiveSpace) bool {
+	if models.InheritUpdatePointerIfChanged(node.IsPrivate, live.InheritParentPermissions) != nil {
+		return false
+	}
+	return sameSpaceTreeAccess(node, SpaceTreeNode{MemberAccess: live.MemberAccess, GroupAccess: live.GroupAccess})
+}
+
+// sameSpaceTreeAccess compares the member and group access, ignoring the order of the entries.
+func sameSpaceTreeAccess(a, b SpaceTreeNode) bool {
+	if len(a.MemberAccess) != len(b.MemberAccess) || len(a.GroupAccess) != len(b.GroupAccess) {
+		return false
+	}
+	members := map[models.SpaceAccessMember]int{}
+	for _, member := range a.MemberAccess {
+		members[member]++
+	}
+	for _, member := range b.MemberAccess {
+		members[member]--
+	}
+	groups := map[models.SpaceAccessGroup]int{}
+	for _, group := range a.GroupAccess {
+		groups[group]++
+	}
+	for _, group := range b.GroupAccess {
+		groups[group]--
+	}
+	for _, count := range members {
+		if count != 0 {
+			return false
+		}
+	}
+	for _, count := range groups {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func summarizeSpaceTreeOperations(operations []SpaceTreeOperation) []string {
+	summary := []string{}
+	for _, operation := range operations {
+		summary = append(summary, string(operation.Type)+":"+operation.Key)
+	}
+	return summary
+}
+
+func TestPlanSpaceTree(t *testing.T) {
+	private := true
+	root := "root-space"
+
+	previous := []SpaceTreeNode{
+		{Key: "a", Name: "A", Children: []SpaceTreeNode{
+			{Key: "a1", Name: "A1", Children: []SpaceTreeNode{
+				{Key: "a11", Name: "A11"},
+			}},
+			{Key: "a2", Name: "A2"},
+		}},
+		{Key: "b", Name: "B"},
+	}
+	spaceUUIDs := map[string]string{"a": "uuid-a", "a1": "uuid-a1", "a11": "uuid-a11", "a2": "uuid-a2", "b": "uuid-b"}
+	live := map[string]SpaceTreeLiveSpace{
+		"a":   {Name: "A", ParentSpaceUUID: &root, InheritParentPermissions: true},
+		"a1":  {Name: "A1", ParentSpaceUUID: strPtr("uuid-a"), InheritParentPermissions: true},
+		"a11": {Name: "A11", ParentSpaceUUID: strPtr("uuid-a1"), InheritParentPermissions: true},
+		"a2":  {Name: "A2", ParentSpaceUUID: strPtr("uuid-a"), InheritParentPermissions: true},
+		"b":   {Name: "B", ParentSpaceUUID: &root, InheritParentPermissions: true},
+	}
+	editor := models.SpaceAccessMember{UserUUID: "user-1", SpaceRole: models.SPACE_EDITOR_ROLE}
+	// a2 was made private and b was shared with a user in Lightdash
+	changedInLightdash := map[string]SpaceTreeLiveSpace{}
+	for key, space := range live {
+		changedInLightdash[key] = space
+	}
+	changedInLightdash["a2"] = SpaceTreeLiveSpace{Name: "A2", ParentSpaceUUID: strPtr("uuid-a")}
+	changedInLightdash["b"] = SpaceTreeLiveSpace{Name: "B", ParentSpaceUUID: &root, InheritParentPermissions: true, MemberAccess: []models.SpaceAccessMember{editor}}
+	public := false
+
+	tests := []struct {
+		name     string
+		desired  []SpaceTreeNode
+		live     map[string]SpaceTreeLiveSpace
+		expected []string
+	}{
+		{
+			name:     "no changes",
+			desired:  previous,
+			live:     live,
+			expected: []string{},
+		},
+		{
+			name: "create, rename and change visibility",
+			desired: []SpaceTreeNode{
+				{Key: "a", Name: "A renamed", Children: []SpaceTreeNode{
+					{Key: "a1", Name: "A1", Children: []SpaceTreeNode{
+						{Key: "a11", Name: "A11"},
+						{Key: "a12", Name: "A12"},
+					}},
+					{Key: "a2", Name: "A2", IsPrivate: &private},
+				}},
+				{Key: "b", Name: "B"},
+			},
+			live:     live,
+			expected: []string{"update:a", "create:a12", "update:a2"},
+		},
+		{
+			name: "revert visibility and access changed in Lightdash",
+			desired: []SpaceTreeNode{
+				{Key: "a", Name: "A", Children: []SpaceTreeNode{
+					{Key: "a1", Name: "A1", Children: []SpaceTreeNode{
+						{Key: "a11", Name: "A11"},
+					}},
+					{Key: "a2", Name: "A2", IsPrivate: &public},
+				}},
+				{Key: "b", Name: "B"},
+			},
+			live:     changedInLightdash,
+			expected: []string{"update:a2", "update:b"},
+		},
+		{
+			name: "move a subtree and delete children first",
+			desired: []SpaceTreeNode{
+				{Key: "b", Name: "B", Children: []SpaceTreeNode{
+					{Key: "a1", Name: "A1", Children: []SpaceTreeNode{
+						{Key: "a11", Name: "A11"},
+					}},
+				}},
+			},
+			live:     live,
+			expected: []string{"move:a1", "delete:a2", "delete:a"},
+		},
+		{
+			name: "move into a new parent",
+			desired: []SpaceTreeNode{
+				{Key: "a", Name: "A", Children: []SpaceTreeNode{
+					{Key: "c", Name: "C", Children: []SpaceTreeNode{
+						{Key: "a1", Name: "A1", Children: []SpaceTreeNode{
+							{Key: "a11", Name: "A11"},
+						}},
+					}},
+					{Key: "a2", Name: "A2"},
+				}},
+				{Key: "b", Name: "B"},
+			},
+			live:     live,
+			expected: []string{"create:c", "move:a1"},
+		},
+		{
+			name:    "recreate a space deleted outside of Terraform",
+			desired: previous,
+			live: map[string]SpaceTreeLiveSpace{
+				"a":   live["a"],
+				"a11": live["a11"],
+				"a2":  live["a2"],
+				"b":   live["b"],
+			},
+			expected: []string{"create:a1", "move:a11"},
+		},
+		{
+			name:     "delete everything",
+			desired:  nil,
+			live:     live,
+			expected: []string{"delete:a11", "delete:a1", "delete:a2", "delete:a", "delete:b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			operations := PlanSpaceTree(test.desired, previous, spaceUUIDs, test.live, &root)
+			got := summarizeSpaceTreeOperations(operations)
+			if !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSpaceTreeNodeMatchesLive(t *testing.T) {
+	private := true
+	editor := models.SpaceAccessMember{UserUUID: "user-1", SpaceRole: models.SPACE_EDITOR_ROLE}
+	viewer := models.SpaceAccessMember{UserUUID: "user-2", SpaceRole: models.SPACE_VIEWER_ROLE}
+	group := models.SpaceAccessGroup{GroupUUID: "group-1", SpaceRole: models.SPACE_VIEWER_ROLE}
+
+	tests := []struct {
+		name     string
+		node     SpaceTreeNode
+		live     SpaceTreeLiveSpace
+		expected bool
+	}{
+		{"empty", SpaceTreeNode{}, SpaceTreeLiveSpace{InheritParentPermissions: true}, true},
+		{"visibility unset", SpaceTreeNode{}, SpaceTreeLiveSpace{}, true},
+		{"visibility changed", SpaceTreeNode{IsPrivate: &private}, SpaceTreeLiveSpace{InheritParentPermissions: true}, false},
+		{"access in another order", SpaceTreeNode{MemberAccess: []models.SpaceAccessMember{editor, viewer}}, SpaceTreeLiveSpace{MemberAccess: []models.SpaceAccessMember{viewer, editor}}, true},
+		{"access changed", SpaceTreeNode{MemberAccess: []models.SpaceAccessMember{editor}}, SpaceTreeLiveSpace{MemberAccess: []models.SpaceAccessMember{viewer}}, false},
+		{"group added", SpaceTreeNode{}, SpaceTreeLiveSpace{GroupAccess: []models.SpaceAccessGroup{group}}, false},
+	}
+
+	for _, test := range tests {
+		if spaceTreeNodeMatchesLive(test.node, test.live) != test.expected {
+			t.Errorf("%s: expected %v", test.name, test.expected)
+		}
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
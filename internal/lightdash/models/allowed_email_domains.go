@@ -0,0 +1,73 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "strings"
+
+// Lightdash rejects these public email providers as allowed email domains,
+// since they would let anyone join the organization.
+var publicEmailDomains = map[string]bool{
+	"126.com":        true,
+	"163.com":        true,
+	"aol.com":        true,
+	"gmail.com":      true,
+	"gmx.com":        true,
+	"gmx.de":         true,
+	"googlemail.com": true,
+	"hotmail.com":    true,
+	"icloud.com":     true,
+	"live.com":       true,
+	"mac.com":        true,
+	"mail.com":       true,
+	"mail.ru":        true,
+	"me.com":         true,
+	"msn.com":        true,
+	"outlook.com":    true,
+	"proton.me":      true,
+	"protonmail.com": true,
+	"qq.com":         true,
+	"yahoo.com":      true,
+	"yandex.com":     true,
+	"yandex.ru":      true,
+	"zoho.com":       true,
+}
+
+// IsPublicEmailDomain reports whether the domain belongs to a public email provider.
+func IsPublicEmailDomain(domain string) bool {
+	return publicEmailDomains[strings.ToLower(strings.TrimSpace(domain))]
+}
+
+// Check if a given role can be granted to users joining through an allowed email domain
+func (e OrganizationMemberRole) IsValidForAllowedEmailDomains() bool {
+	switch e {
+	case ORGANIZATION_MEMBER_ROLE,
+		ORGANIZATION_VIEWER_ROLE,
+		ORGANIZATION_INTERACTIVE_VIEWER_ROLE,
+		ORGANIZATION_EDITOR_ROLE:
+		return true
+	}
+	return false
+}
+
+// Check if a given project role can be granted to users joining through an allowed email domain
+func (s ProjectMemberRole) IsValidForAllowedEmailDomains() bool {
+	switch s {
+	case PROJECT_VIEWER_ROLE,
+		PROJECT_INTERACTIVE_VIEWER_ROLE,
+		PROJECT_EDITOR_ROLE:
+		return true
+	}
+	return false
+}
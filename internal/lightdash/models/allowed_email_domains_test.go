@@ -0,0 +1,72 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestIsPublicEmailDomain(t *testing.T) {
+	tests := []struct {
+		domain   string
+		expected bool
+	}{
+		{"gmail.com", true},
+		{"Gmail.com", true},
+		{" outlook.com ", true},
+		{"example.com", false},
+		{"mail.example.com", false},
+	}
+
+	for _, test := range tests {
+		if IsPublicEmailDomain(test.domain) != test.expected {
+			t.Errorf("Expected %v for domain %q", test.expected, test.domain)
+		}
+	}
+}
+
+func TestIsValidForAllowedEmailDomains(t *testing.T) {
+	organizationRoles := []struct {
+		role     OrganizationMemberRole
+		expected bool
+	}{
+		{ORGANIZATION_MEMBER_ROLE, true},
+		{ORGANIZATION_VIEWER_ROLE, true},
+		{ORGANIZATION_EDITOR_ROLE, true},
+		{ORGANIZATION_DEVELOPER_ROLE, false},
+		{ORGANIZATION_ADMIN_ROLE, false},
+	}
+	for _, test := range organizationRoles {
+		if test.role.IsValidForAllowedEmailDomains() != test.expected {
+			t.Errorf("Expected %v for organization role %s", test.expected, test.role)
+		}
+	}
+
+	projectRoles := []struct {
+		role     ProjectMemberRole
+		expected bool
+	}{
+		{PROJECT_VIEWER_ROLE, true},
+		{PROJECT_INTERACTIVE_VIEWER_ROLE, true},
+		{PROJECT_EDITOR_ROLE, true},
+		{PROJECT_DEVELOPER_ROLE, false},
+		{PROJECT_ADMIN_ROLE, false},
+	}
+	for _, test := range projectRoles {
+		if test.role.IsValidForAllowedEmailDomains() != test.expected {
+			t.Errorf("Expected %v for project role %s", test.expected, test.role)
+		}
+	}
+}
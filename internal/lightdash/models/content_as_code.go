@@ -0,0 +1,137 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// contentAsCodeVolatileKeys are the top-level keys that change on every download
+// and are ignored when comparing content.
+var contentAsCodeVolatileKeys = []string{"updatedAt", "downloadedAt"}
+
+// ContentAsCode is a chart or dashboard in the format written by `lightdash download`.
+type ContentAsCode struct {
+	Type     ContentType
+	Slug     string
+	Document map[string]any
+}
+
+func NewContentAsCode(contentType ContentType, document map[string]any) (*ContentAsCode, error) {
+	if !contentType.IsValid() {
+		return nil, fmt.Errorf("invalid content type %q", contentType)
+	}
+	slug, ok := document["slug"].(string)
+	if !ok || slug == "" {
+		return nil, fmt.Errorf("%s document is missing a slug", contentType)
+	}
+	return &ContentAsCode{Type: contentType, Slug: slug, Document: document}, nil
+}
+
+// Key identifies the content within a project, such as `chart/weekly-orders`.
+func (c ContentAsCode) Key() string {
+	return fmt.Sprintf("%s/%s", c.Type, c.Slug)
+}
+
+// Hash returns a digest of the document that ignores formatting, key order and volatile keys.
+func (c ContentAsCode) Hash() (string, error) {
+	normalized := make(map[string]any, len(c.Document))
+	for key, value := range c.Document {
+		normalized[key] = value
+	}
+	for _, key := range contentAsCodeVolatileKeys {
+		delete(normalized, key)
+	}
+
+	// encoding/json sorts map keys, which makes the output canonical.
+	marshalled, err := json.Marshal(normalized)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %s: %w", c.Key(), err)
+	}
+	sum := sha256.Sum256(marshalled)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// DetectContentType tells charts and dashboards apart by their fields.
+func DetectContentType(document map[string]any) ContentType {
+	if _, ok := document["tiles"]; ok {
+		return CONTENT_TYPE_DASHBOARD
+	}
+	return CONTENT_TYPE_CHART
+}
+
+// ParseContentAsCodeYAML parses a YAML document into JSON-compatible values.
+// Timestamps are kept as strings so that they compare equal to the API response.
+func ParseContentAsCodeYAML(data []byte) (map[string]any, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if node.Kind != yaml.DocumentNode || len(node.Content) == 0 {
+		return nil, fmt.Errorf("YAML document is empty")
+	}
+
+	value, err := yamlNodeToValue(node.Content[0])
+	if err != nil {
+		return nil, err
+	}
+	document, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("YAML document must be a mapping")
+	}
+	return document, nil
+}
+
+func yamlNodeToValue(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		result := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := yamlNodeToValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			result[node.Content[i].Value] = value
+		}
+		return result, nil
+	case yaml.SequenceNode:
+		result := make([]any, 0, len(node.Content))
+		for _, child := range node.Content {
+			value, err := yamlNodeToValue(child)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, value)
+		}
+		return result, nil
+	case yaml.AliasNode:
+		return yamlNodeToValue(node.Alias)
+	case yaml.ScalarNode:
+		if node.Tag == "!!timestamp" {
+			return node.Value, nil
+		}
+		var value any
+		if err := node.Decode(&value); err != nil {
+			return nil, fmt.Errorf("failed to decode YAML value at line %d: %w", node.Line, err)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("unsupported YAML node at line %d", node.Line)
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseContentAsCodeYAML(t *testing.T) {
+	document, err := ParseContentAsCodeYAML([]byte(`
+version: 1
+name: Weekly orders
+slug: weekly-orders
+spaceSlug: operations
+updatedAt: 2026-03-01T09:00:00.000Z
+metricQuery:
+  limit: 500
+  dimensions:
+    - orders_created_week
+`))
+	if err != nil {
+		t.Fatalf("ParseContentAsCodeYAML: %v", err)
+	}
+	if document["updatedAt"] != "2026-03-01T09:00:00.000Z" {
+		t.Errorf("expected timestamp to be kept as a string, got %#v", document["updatedAt"])
+	}
+	metricQuery, ok := document["metricQuery"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected metricQuery to be a mapping, got %#v", document["metricQuery"])
+	}
+	if metricQuery["limit"] != 500 {
+		t.Errorf("unexpected limit: %#v", metricQuery["limit"])
+	}
+
+	if _, err := ParseContentAsCodeYAML([]byte(`- not a mapping`)); err == nil {
+		t.Error("expected error for a sequence document")
+	}
+}
+
+func TestContentAsCodeHash(t *testing.T) {
+	fromYAML, err := ParseContentAsCodeYAML([]byte(`
+slug: weekly-orders
+name: Weekly orders
+updatedAt: 2026-03-01T09:00:00.000Z
+metricQuery:
+  limit: 500
+`))
+	if err != nil {
+		t.Fatalf("ParseContentAsCodeYAML: %v", err)
+	}
+	var fromAPI map[string]any
+	if err := json.Unmarshal([]byte(`{"name":"Weekly orders","slug":"weekly-orders","updatedAt":"2026-03-02T09:00:00.000Z","metricQuery":{"limit":500}}`), &fromAPI); err != nil {
+		t.Fatalf("failed to unmarshal JSON: %v", err)
+	}
+
+	local, err := NewContentAsCode(DetectContentType(fromYAML), fromYAML)
+	if err != nil {
+		t.Fatalf("NewContentAsCode: %v", err)
+	}
+	remote, err := NewContentAsCode(CONTENT_TYPE_CHART, fromAPI)
+	if err != nil {
+		t.Fatalf("NewContentAsCode: %v", err)
+	}
+	if local.Key() != "chart/weekly-orders" {
+		t.Errorf("unexpected key: %s", local.Key())
+	}
+
+	localHash, _ := local.Hash()
+	remoteHash, _ := remote.Hash()
+	if localHash != remoteHash {
+		t.Error("expected hashes to ignore formatting and volatile keys")
+	}
+
+	fromAPI["name"] = "Orders per week"
+	changedHash, _ := remote.Hash()
+	if changedHash == localHash {
+		t.Error("expected hash to change with the content")
+	}
+}
+
+func TestNewContentAsCode_missingSlug(t *testing.T) {
+	if _, err := NewContentAsCode(CONTENT_TYPE_DASHBOARD, map[string]any{"tiles": []any{}}); err == nil {
+		t.Error("expected error for a document without slug")
+	}
+}
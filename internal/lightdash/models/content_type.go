@@ -0,0 +1,38 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+type ContentType string
+
+// List of ContentType
+const (
+	CONTENT_TYPE_CHART     ContentType = "chart"
+	CONTENT_TYPE_DASHBOARD ContentType = "dashboard"
+)
+
+// convert ContentType to string
+func (t ContentType) String() string {
+	return string(t)
+}
+
+// Check if a given string is a valid ContentType
+func (t ContentType) IsValid() bool {
+	switch t {
+	case CONTENT_TYPE_CHART,
+		CONTENT_TYPE_DASHBOARD:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+type DashboardTileType string
+
+// List of DashboardTileType
+const (
+	DASHBOARD_SAVED_CHART_TILE DashboardTileType = "saved_chart"
+	DASHBOARD_SQL_CHART_TILE   DashboardTileType = "sql_chart"
+	DASHBOARD_MARKDOWN_TILE    DashboardTileType = "markdown"
+	DASHBOARD_LOOM_TILE        DashboardTileType = "loom"
+)
+
+// convert DashboardTileType to string
+func (t DashboardTileType) String() string {
+	return string(t)
+}
+
+// Check if a given string is a valid DashboardTileType
+func (t DashboardTileType) IsValid() bool {
+	switch t {
+	case DASHBOARD_SAVED_CHART_TILE,
+		DASHBOARD_SQL_CHART_TILE,
+		DASHBOARD_MARKDOWN_TILE,
+		DASHBOARD_LOOM_TILE:
+		return true
+	}
+	return false
+}
+
+// Return the list of valid DashboardTileType values
+func DashboardTileTypes() []DashboardTileType {
+	return []DashboardTileType{
+		DASHBOARD_SAVED_CHART_TILE,
+		DASHBOARD_SQL_CHART_TILE,
+		DASHBOARD_MARKDOWN_TILE,
+		DASHBOARD_LOOM_TILE,
+	}
+}
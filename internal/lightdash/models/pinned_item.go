@@ -0,0 +1,71 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+type PinnedItemType string
+
+// List of PinnedItemType
+const (
+	PINNED_ITEM_CHART     PinnedItemType = "chart"
+	PINNED_ITEM_DASHBOARD PinnedItemType = "dashboard"
+	PINNED_ITEM_SPACE     PinnedItemType = "space"
+)
+
+// convert PinnedItemType to string
+func (t PinnedItemType) String() string {
+	return string(t)
+}
+
+// Check if a given string is a valid PinnedItemType
+func (t PinnedItemType) IsValid() bool {
+	switch t {
+	case PINNED_ITEM_CHART,
+		PINNED_ITEM_DASHBOARD,
+		PINNED_ITEM_SPACE:
+		return true
+	}
+	return false
+}
+
+// PinnedItem is a space, chart or dashboard pinned to a project homepage.
+type PinnedItem struct {
+	Type PinnedItemType
+	UUID string
+}
+
+// DiffPinnedItems returns the items to pin and to unpin to go from current to desired.
+// The order of the items is handled separately.
+func DiffPinnedItems(current, desired []PinnedItem) (toPin []PinnedItem, toUnpin []PinnedItem) {
+	currentSet := make(map[PinnedItem]bool, len(current))
+	for _, item := range current {
+		currentSet[item] = true
+	}
+	desiredSet := make(map[PinnedItem]bool, len(desired))
+	for _, item := range desired {
+		desiredSet[item] = true
+	}
+
+	for _, item := range desired {
+		if !currentSet[item] {
+			toPin = append(toPin, item)
+		}
+	}
+	for _, item := range current {
+		if !desiredSet[item] {
+			toUnpin = append(toUnpin, item)
+		}
+	}
+	return toPin, toUnpin
+}
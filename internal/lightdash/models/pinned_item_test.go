@@ -0,0 +1,57 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsValidPinnedItemType(t *testing.T) {
+	tests := []struct {
+		itemType string
+		expected bool
+	}{
+		{"chart", true},
+		{"dashboard", true},
+		{"space", true},
+		{"sql_chart", false},
+	}
+
+	for _, test := range tests {
+		if PinnedItemType(test.itemType).IsValid() != test.expected {
+			t.Errorf("Expected %v for pinned item type %s", test.expected, test.itemType)
+		}
+	}
+}
+
+func TestDiffPinnedItems(t *testing.T) {
+	space := PinnedItem{Type: PINNED_ITEM_SPACE, UUID: "space-uuid"}
+	chart := PinnedItem{Type: PINNED_ITEM_CHART, UUID: "chart-uuid"}
+	dashboard := PinnedItem{Type: PINNED_ITEM_DASHBOARD, UUID: "dashboard-uuid"}
+
+	toPin, toUnpin := DiffPinnedItems([]PinnedItem{space, chart}, []PinnedItem{dashboard, space})
+	if !reflect.DeepEqual(toPin, []PinnedItem{dashboard}) {
+		t.Errorf("unexpected items to pin: %v", toPin)
+	}
+	if !reflect.DeepEqual(toUnpin, []PinnedItem{chart}) {
+		t.Errorf("unexpected items to unpin: %v", toUnpin)
+	}
+
+	toPin, toUnpin = DiffPinnedItems([]PinnedItem{chart, space}, []PinnedItem{space, chart})
+	if len(toPin) != 0 || len(toUnpin) != 0 {
+		t.Errorf("expected no changes for reordered items, got pin=%v unpin=%v", toPin, toUnpin)
+	}
+}
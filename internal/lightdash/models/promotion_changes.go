@@ -0,0 +1,37 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// PromotionChangeData describes a piece of content changed by an upload or a promotion.
+type PromotionChangeData struct {
+	UUID        string  `json:"uuid"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	ProjectUUID string  `json:"projectUuid"`
+	SpaceUUID   *string `json:"spaceUuid,omitempty"`
+}
+
+type PromotionChange struct {
+	// One of `create`, `update`, `delete` or `no changes`.
+	Action string              `json:"action"`
+	Data   PromotionChangeData `json:"data"`
+}
+
+// PromotionChanges lists the content changed by an upload or a promotion.
+type PromotionChanges struct {
+	Spaces     []PromotionChange `json:"spaces"`
+	Dashboards []PromotionChange `json:"dashboards"`
+	Charts     []PromotionChange `json:"charts"`
+}
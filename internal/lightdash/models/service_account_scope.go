@@ -0,0 +1,42 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+type ServiceAccountScope string
+
+// List of ServiceAccountScope
+const (
+	SERVICE_ACCOUNT_ORG_ADMIN_SCOPE   ServiceAccountScope = "org:admin"
+	SERVICE_ACCOUNT_ORG_EDIT_SCOPE    ServiceAccountScope = "org:edit"
+	SERVICE_ACCOUNT_ORG_READ_SCOPE    ServiceAccountScope = "org:read"
+	SERVICE_ACCOUNT_SCIM_MANAGE_SCOPE ServiceAccountScope = "scim:manage"
+)
+
+// convert ServiceAccountScope to string
+func (s ServiceAccountScope) String() string {
+	return string(s)
+}
+
+// Check if a given string is a valid ServiceAccountScope
+func (s ServiceAccountScope) IsValid() bool {
+	switch s {
+	case SERVICE_ACCOUNT_ORG_ADMIN_SCOPE,
+		SERVICE_ACCOUNT_ORG_EDIT_SCOPE,
+		SERVICE_ACCOUNT_ORG_READ_SCOPE,
+		SERVICE_ACCOUNT_SCIM_MANAGE_SCOPE:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestIsValidServiceAccountScope(t *testing.T) {
+	tests := []struct {
+		scope    string
+		expected bool
+	}{
+		{"org:admin", true},
+		{"org:edit", true},
+		{"org:read", true},
+		{"scim:manage", true},
+		{"org:write", false},
+		{"admin", false},
+	}
+
+	for _, test := range tests {
+		if ServiceAccountScope(test.scope).IsValid() != test.expected {
+			t.Errorf("Expected %v for scope %s", test.expected, test.scope)
+		}
+	}
+}
@@ -14,6 +14,11 @@
 
 package models
 
+import (
+	"fmt"
+	"strings"
+)
+
 // SpaceAccessMember represents the core information for a space access member used in requests.
 type SpaceAccessMember struct {
 	UserUUID  string
@@ -66,6 +71,33 @@ func (s *SpaceMemberAccess) HasDirectSpaceMemberAccess() bool {
 		(s.InheritedFrom == nil || *s.InheritedFrom != "group")
 }
 
+// GetSpaceAccessSource resolves where the access of a member comes from,
+// based on the inheritedFrom and inheritedRole fields of the API response.
+func (s *SpaceMemberAccess) GetSpaceAccessSource() SpaceAccessSource {
+	if s.HasDirectSpaceMemberAccess() {
+		return SPACE_ACCESS_SOURCE_DIRECT
+	}
+
+	inheritedFrom := ""
+	if s.InheritedFrom != nil {
+		inheritedFrom = *s.InheritedFrom
+	}
+	switch inheritedFrom {
+	case "group", "space_group":
+		return SPACE_ACCESS_SOURCE_GROUP
+	case "parent_space":
+		return SPACE_ACCESS_SOURCE_PARENT_SPACE
+	case "project":
+		return SPACE_ACCESS_SOURCE_PROJECT_ROLE
+	case "organization":
+		if s.InheritedRole != nil && *s.InheritedRole == ORGANIZATION_ADMIN_ROLE.String() {
+			return SPACE_ACCESS_SOURCE_ORG_ADMIN
+		}
+		return SPACE_ACCESS_SOURCE_ORGANIZATION_ROLE
+	}
+	return SPACE_ACCESS_SOURCE_UNKNOWN
+}
+
 // ChildSpace represents a nested space within a parent space
 type ChildSpace struct {
 	SpaceUUID                string
@@ -136,3 +168,114 @@ func (s *SpaceDetails) GetGroupByUUID(groupUUID string) *SpaceAccessGroup {
 func (s *SpaceDetails) ResourceID() string {
 	return "projects/" + s.ProjectUUID + "/spaces/" + s.SpaceUUID
 }
+
+// SpaceContent is a chart or a dashboard stored directly in a space
+type SpaceContent struct {
+	UUID        string
+	Name        string
+	ContentType ContentType
+	// SQLChart is true for charts built in the SQL runner rather than from an explore
+	SQLChart bool
+}
+
+// ContentLocation is the space holding a chart or a dashboard
+type ContentLocation struct {
+	SpaceUUID string
+	// SQLChart is true for charts built in the SQL runner rather than from an explore
+	SQLChart bool
+}
+
+// SpaceContentTree is a space together with its content and its nested spaces
+type SpaceContentTree struct {
+	SpaceUUID   string
+	SpaceName   string
+	Contents    []SpaceContent
+	ChildSpaces []SpaceContentTree
+}
+
+// IsEmpty returns true if the space holds no content and no nested spaces
+func (t *SpaceContentTree) IsEmpty() bool {
+	return len(t.Contents) == 0 && len(t.ChildSpaces) == 0
+}
+
+// Contains returns true if the given space UUID is this space or one of its descendants
+func (t *SpaceContentTree) Contains(spaceUUID string) bool {
+	if t.SpaceUUID == spaceUUID {
+		return true
+	}
+	for i := range t.ChildSpaces {
+		if t.ChildSpaces[i].Contains(spaceUUID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe lists the nested spaces and content of the tree as human-readable lines,
+// e.g. `space "Parent/Child"` or `chart "Parent/Child/Revenue"`.
+// The root space itself is not listed.
+func (t *SpaceContentTree) Describe() []string {
+	return t.describe(t.SpaceName)
+}
+
+func (t *SpaceContentTree) describe(prefix string) []string {
+	lines := []string{}
+	for _, content := range t.Contents {
+		label := content.ContentType.String()
+		if content.SQLChart {
+			label = "SQL chart"
+		}
+		lines = append(lines, fmt.Sprintf("%s %q", label, prefix+"/"+content.Name))
+	}
+	for i := range t.ChildSpaces {
+		child := &t.ChildSpaces[i]
+		childPath := prefix + "/" + child.SpaceName
+		lines = append(lines, fmt.Sprintf("space %q", childPath))
+		lines = append(lines, child.describe(childPath)...)
+	}
+	return lines
+}
+
+// ParseSpacePath splits a slash-separated space path such as "Marketing/Campaigns/2026"
+// into space names. A slash that is part of a name is escaped as `\/`, and a backslash as `\\`.
+// Whitespace around each name is ignored.
+func ParseSpacePath(path string) ([]string, error) {
+	names := []string{}
+	var current strings.Builder
+	escaped := false
+	for _, r := range path {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '/':
+			names = append(names, strings.TrimSpace(current.String()))
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("space path %q ends with an incomplete escape sequence", path)
+	}
+	names = append(names, strings.TrimSpace(current.String()))
+
+	for i, name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("space path %q has an empty name at position %d", path, i+1)
+		}
+	}
+	return names, nil
+}
+
+// FormatSpacePath joins space names into a slash-separated path, escaping slashes in names.
+func FormatSpacePath(names []string) string {
+	escaped := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ReplaceAll(name, `\`, `\\`)
+		escaped = append(escaped, strings.ReplaceAll(name, "/", `\/`))
+	}
+	return strings.Join(escaped, "/")
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// SpaceAccessSource describes where the effective access of a user to a space comes from.
+type SpaceAccessSource string
+
+// List of SpaceAccessSource
+const (
+	// Access granted to the user on the space itself
+	SPACE_ACCESS_SOURCE_DIRECT SpaceAccessSource = "direct"
+	// Access granted to a group the user belongs to
+	SPACE_ACCESS_SOURCE_GROUP SpaceAccessSource = "group"
+	// Access inherited from a parent space
+	SPACE_ACCESS_SOURCE_PARENT_SPACE SpaceAccessSource = "parent_space"
+	// Access derived from the project role of the user
+	SPACE_ACCESS_SOURCE_PROJECT_ROLE SpaceAccessSource = "project_role"
+	// Access derived from the organization role of the user
+	SPACE_ACCESS_SOURCE_ORGANIZATION_ROLE SpaceAccessSource = "organization_role"
+	// Implicit access of organization administrators
+	SPACE_ACCESS_SOURCE_ORG_ADMIN SpaceAccessSource = "org_admin"
+	// The API returned an origin the provider does not know
+	SPACE_ACCESS_SOURCE_UNKNOWN SpaceAccessSource = "unknown"
+)
+
+// convert SpaceAccessSource to string
+func (s SpaceAccessSource) String() string {
+	return string(s)
+}
+
+// Check if a given string is a valid SpaceAccessSource
+func (s SpaceAccessSource) IsValid() bool {
+	switch s {
+	case SPACE_ACCESS_SOURCE_DIRECT,
+		SPACE_ACCESS_SOURCE_GROUP,
+		SPACE_ACCESS_SOURCE_PARENT_SPACE,
+		SPACE_ACCESS_SOURCE_PROJECT_ROLE,
+		SPACE_ACCESS_SOURCE_ORGANIZATION_ROLE,
+		SPACE_ACCESS_SOURCE_ORG_ADMIN,
+		SPACE_ACCESS_SOURCE_UNKNOWN:
+		return true
+	}
+	return false
+}
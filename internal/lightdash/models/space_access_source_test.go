@@ -0,0 +1,42 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestIsValidSpaceAccessSource(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected bool
+	}{
+		{"direct", true},
+		{"group", true},
+		{"parent_space", true},
+		{"project_role", true},
+		{"organization_role", true},
+		{"org_admin", true},
+		{"unknown", true},
+		{"space_group", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		if SpaceAccessSource(test.source).IsValid() != test.expected {
+			t.Errorf("Expected %v for source %s", test.expected, test.source)
+		}
+	}
+}
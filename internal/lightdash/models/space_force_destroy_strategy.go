@@ -0,0 +1,42 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// SpaceForceDestroyStrategy decides what happens to the child spaces and content
+// of a space when it is destroyed.
+type SpaceForceDestroyStrategy string
+
+// List of SpaceForceDestroyStrategy
+const (
+	// Move child spaces, charts and dashboards to the parent or a fallback space
+	SPACE_FORCE_DESTROY_RELOCATE SpaceForceDestroyStrategy = "relocate"
+	// Delete child spaces recursively together with their charts and dashboards
+	SPACE_FORCE_DESTROY_DELETE SpaceForceDestroyStrategy = "delete"
+)
+
+// convert SpaceForceDestroyStrategy to string
+func (s SpaceForceDestroyStrategy) String() string {
+	return string(s)
+}
+
+// Check if a given string is a valid SpaceForceDestroyStrategy
+func (s SpaceForceDestroyStrategy) IsValid() bool {
+	switch s {
+	case SPACE_FORCE_DESTROY_RELOCATE,
+		SPACE_FORCE_DESTROY_DELETE:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+)
+
+func TestIsValidSpaceForceDestroyStrategy(t *testing.T) {
+	tests := []struct {
+		strategy string
+		expected bool
+	}{
+		{"relocate", true},
+		{"delete", true},
+		{"move", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		if SpaceForceDestroyStrategy(test.strategy).IsValid() != test.expected {
+			t.Errorf("Expected %v for strategy %s", test.expected, test.strategy)
+		}
+	}
+}
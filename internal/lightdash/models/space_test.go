@@ -0,0 +1,170 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpaceContentTree(t *testing.T) {
+	tree := SpaceContentTree{
+		SpaceUUID: "root",
+		SpaceName: "Root",
+		Contents: []SpaceContent{
+			{UUID: "chart-1", Name: "Revenue", ContentType: CONTENT_TYPE_CHART},
+		},
+		ChildSpaces: []SpaceContentTree{
+			{
+				SpaceUUID: "child",
+				SpaceName: "Child",
+				Contents: []SpaceContent{
+					{UUID: "dashboard-1", Name: "KPIs", ContentType: CONTENT_TYPE_DASHBOARD},
+					{UUID: "sql-chart-1", Name: "Churn", ContentType: CONTENT_TYPE_CHART, SQLChart: true},
+				},
+				ChildSpaces: []SpaceContentTree{
+					{SpaceUUID: "grandchild", SpaceName: "Grandchild"},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		`chart "Root/Revenue"`,
+		`space "Root/Child"`,
+		`dashboard "Root/Child/KPIs"`,
+		`SQL chart "Root/Child/Churn"`,
+		`space "Root/Child/Grandchild"`,
+	}
+	if got := tree.Describe(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Describe() = %v, expected %v", got, expected)
+	}
+
+	tests := []struct {
+		spaceUUID string
+		expected  bool
+	}{
+		{"root", true},
+		{"child", true},
+		{"grandchild", true},
+		{"other", false},
+	}
+	for _, test := range tests {
+		if tree.Contains(test.spaceUUID) != test.expected {
+			t.Errorf("Expected Contains(%s) to be %v", test.spaceUUID, test.expected)
+		}
+	}
+
+	if tree.IsEmpty() {
+		t.Errorf("Expected tree not to be empty")
+	}
+	if !tree.ChildSpaces[0].ChildSpaces[0].IsEmpty() {
+		t.Errorf("Expected grandchild to be empty")
+	}
+}
+
+func TestParseSpacePath(t *testing.T) {
+	tests := []struct {
+		path        string
+		expected    []string
+		expectError bool
+	}{
+		{"Marketing", []string{"Marketing"}, false},
+		{"Marketing/Campaigns/2026", []string{"Marketing", "Campaigns", "2026"}, false},
+		{" Marketing / Campaigns ", []string{"Marketing", "Campaigns"}, false},
+		{`Sales\/Revenue/Q1`, []string{"Sales/Revenue", "Q1"}, false},
+		{`Back\\slash`, []string{`Back\slash`}, false},
+		{"", nil, true},
+		{"Marketing//Campaigns", nil, true},
+		{"Marketing/", nil, true},
+		{`Marketing\`, nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseSpacePath(test.path)
+		if (err != nil) != test.expectError {
+			t.Errorf("ParseSpacePath(%q) error = %v, expectError %v", test.path, err, test.expectError)
+			continue
+		}
+		if !test.expectError && !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("ParseSpacePath(%q) = %v, expected %v", test.path, got, test.expected)
+		}
+		if !test.expectError {
+			if formatted, _ := ParseSpacePath(FormatSpacePath(got)); !reflect.DeepEqual(formatted, got) {
+				t.Errorf("FormatSpacePath(%v) does not round-trip", got)
+			}
+		}
+	}
+}
+
+func TestGetSpaceAccessSource(t *testing.T) {
+	yes, no := true, false
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name     string
+		member   SpaceMemberAccess
+		expected SpaceAccessSource
+	}{
+		{
+			name:     "direct member",
+			member:   SpaceMemberAccess{HasDirectAccess: &yes, InheritedFrom: str("")},
+			expected: SPACE_ACCESS_SOURCE_DIRECT,
+		},
+		{
+			name:     "group on the space",
+			member:   SpaceMemberAccess{HasDirectAccess: &yes, InheritedFrom: str("group")},
+			expected: SPACE_ACCESS_SOURCE_GROUP,
+		},
+		{
+			name:     "space group",
+			member:   SpaceMemberAccess{HasDirectAccess: &no, InheritedFrom: str("space_group")},
+			expected: SPACE_ACCESS_SOURCE_GROUP,
+		},
+		{
+			name:     "parent space",
+			member:   SpaceMemberAccess{HasDirectAccess: &no, InheritedFrom: str("parent_space")},
+			expected: SPACE_ACCESS_SOURCE_PARENT_SPACE,
+		},
+		{
+			name:     "project role",
+			member:   SpaceMemberAccess{HasDirectAccess: &no, InheritedFrom: str("project"), InheritedRole: str("editor")},
+			expected: SPACE_ACCESS_SOURCE_PROJECT_ROLE,
+		},
+		{
+			name:     "organization admin",
+			member:   SpaceMemberAccess{HasDirectAccess: &no, InheritedFrom: str("organization"), InheritedRole: str("admin")},
+			expected: SPACE_ACCESS_SOURCE_ORG_ADMIN,
+		},
+		{
+			name:     "organization role",
+			member:   SpaceMemberAccess{HasDirectAccess: &no, InheritedFrom: str("organization"), InheritedRole: str("viewer")},
+			expected: SPACE_ACCESS_SOURCE_ORGANIZATION_ROLE,
+		},
+		{
+			name:     "unknown origin",
+			member:   SpaceMemberAccess{},
+			expected: SPACE_ACCESS_SOURCE_UNKNOWN,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.member.GetSpaceAccessSource(); got != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
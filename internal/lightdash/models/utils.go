@@ -18,3 +18,11 @@ package models
 func IsEmptyStringPointer(s *string) bool {
 	return s == nil || *s == ""
 }
+
+// EqualOptionalStrings returns true if both string pointers are empty or point to equal strings
+func EqualOptionalStrings(a, b *string) bool {
+	if IsEmptyStringPointer(a) || IsEmptyStringPointer(b) {
+		return IsEmptyStringPointer(a) && IsEmptyStringPointer(b)
+	}
+	return *a == *b
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var (
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	ErrScimTokenNotFound           = errors.New("SCIM token not found")
+)
+
+// AccessTokenService manages the tokens of one access token endpoint
+type AccessTokenService struct {
+	client      *api.Client
+	endpoint    apiv1.AccessTokenEndpointV1
+	errNotFound error
+}
+
+// NewPersonalAccessTokenService manages the personal access tokens of the authenticated user
+func NewPersonalAccessTokenService(client *api.Client) *AccessTokenService {
+	return &AccessTokenService{client: client, endpoint: apiv1.PersonalAccessTokensV1, errNotFound: ErrPersonalAccessTokenNotFound}
+}
+
+// NewScimTokenService manages the SCIM access tokens of the organization
+func NewScimTokenService(client *api.Client) *AccessTokenService {
+	return &AccessTokenService{client: client, endpoint: apiv1.ScimTokensV1, errNotFound: ErrScimTokenNotFound}
+}
+
+// GetToken looks the token up in the tokens of the endpoint.
+func (s *AccessTokenService) GetToken(ctx context.Context, tokenUUID string) (*apiv1.AccessTokenV1, error) {
+	_ = ctx
+	tokens, err := apiv1.ListAccessTokensV1(s.client, s.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tokens {
+		if tokens[i].UUID == tokenUUID {
+			return &tokens[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: token UUID %q", s.errNotFound, tokenUUID)
+}
+
+// CreateToken creates a token that expires after the given duration, or never if it is zero.
+func (s *AccessTokenService) CreateToken(ctx context.Context, description string, expiresIn time.Duration) (*apiv1.AccessTokenWithTokenV1, error) {
+	_ = ctx
+	request := apiv1.CreateAccessTokenV1Request{Description: description}
+	if expiresIn > 0 {
+		expiresAt := time.Now().Add(expiresIn).UTC().Format(time.RFC3339)
+		request.ExpiresAt = &expiresAt
+	}
+	return apiv1.CreateAccessTokenV1(s.client, s.endpoint, request)
+}
+
+// RotateToken replaces the token value with one that expires after the given duration.
+func (s *AccessTokenService) RotateToken(ctx context.Context, tokenUUID string, expiresIn time.Duration) (*apiv1.AccessTokenWithTokenV1, error) {
+	_ = ctx
+	return apiv1.RotateAccessTokenV1(s.client, s.endpoint, tokenUUID, apiv1.RotateAccessTokenV1Request{
+		ExpiresAt: time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+	})
+}
+
+// DeleteToken revokes the token. A token that is already gone is not an error.
+func (s *AccessTokenService) DeleteToken(ctx context.Context, tokenUUID string) error {
+	_ = ctx
+	err := apiv1.DeleteAccessTokenV1(s.client, s.endpoint, tokenUUID)
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
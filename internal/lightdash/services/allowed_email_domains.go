@@ -0,0 +1,55 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type AllowedEmailDomainsService struct {
+	client *api.Client
+}
+
+func NewAllowedEmailDomainsService(client *api.Client) *AllowedEmailDomainsService {
+	return &AllowedEmailDomainsService{client: client}
+}
+
+func (s *AllowedEmailDomainsService) GetAllowedEmailDomains(ctx context.Context) (*apiv1.AllowedEmailDomainsV1, error) {
+	_ = ctx
+	return apiv1.GetAllowedEmailDomainsV1(s.client)
+}
+
+func (s *AllowedEmailDomainsService) UpdateAllowedEmailDomains(ctx context.Context, allowed apiv1.AllowedEmailDomainsV1) (*apiv1.AllowedEmailDomainsV1, error) {
+	_ = ctx
+	if allowed.EmailDomains == nil {
+		allowed.EmailDomains = []string{}
+	}
+	if allowed.Projects == nil {
+		allowed.Projects = []apiv1.AllowedEmailDomainProjectV1{}
+	}
+	return apiv1.UpdateAllowedEmailDomainsV1(s.client, allowed)
+}
+
+// ClearAllowedEmailDomains removes all allowed email domains, so that users can only join the organization by invitation.
+func (s *AllowedEmailDomainsService) ClearAllowedEmailDomains(ctx context.Context) error {
+	_, err := s.UpdateAllowedEmailDomains(ctx, apiv1.AllowedEmailDomainsV1{
+		Role: models.ORGANIZATION_VIEWER_ROLE,
+	})
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrColorPaletteNotFound = errors.New("color palette not found")
+
+type ColorPaletteService struct {
+	client *api.Client
+}
+
+func NewColorPaletteService(client *api.Client) *ColorPaletteService {
+	return &ColorPaletteService{client: client}
+}
+
+func (s *ColorPaletteService) ListColorPalettes(ctx context.Context) ([]apiv1.ColorPaletteV1, error) {
+	_ = ctx
+	return apiv1.ListColorPalettesV1(s.client)
+}
+
+// GetColorPalette looks the palette up in the list of palettes, since Lightdash has no endpoint to get a single one.
+func (s *ColorPaletteService) GetColorPalette(ctx context.Context, colorPaletteUUID string) (*apiv1.ColorPaletteV1, error) {
+	palettes, err := s.ListColorPalettes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range palettes {
+		if palettes[i].ColorPaletteUUID == colorPaletteUUID {
+			return &palettes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: color palette UUID %q", ErrColorPaletteNotFound, colorPaletteUUID)
+}
+
+// CreateColorPalette creates the palette, activates it if requested and returns it as read back from Lightdash.
+func (s *ColorPaletteService) CreateColorPalette(ctx context.Context, name string, colors []string, active bool) (*apiv1.ColorPaletteV1, error) {
+	created, err := apiv1.CreateColorPaletteV1(s.client, apiv1.CreateColorPaletteV1Request{
+		Name:   name,
+		Colors: colors,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		if err := apiv1.SetActiveColorPaletteV1(s.client, created.ColorPaletteUUID); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetColorPalette(ctx, created.ColorPaletteUUID)
+}
+
+// UpdateColorPalette updates the palette, activates it if requested and returns it as read back from Lightdash.
+func (s *ColorPaletteService) UpdateColorPalette(ctx context.Context, colorPaletteUUID string, name string, colors []string, active bool) (*apiv1.ColorPaletteV1, error) {
+	if err := apiv1.UpdateColorPaletteV1(s.client, apiv1.UpdateColorPaletteV1Request{
+		UUID:   colorPaletteUUID,
+		Name:   name,
+		Colors: colors,
+	}); err != nil {
+		return nil, err
+	}
+	if active {
+		if err := apiv1.SetActiveColorPaletteV1(s.client, colorPaletteUUID); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetColorPalette(ctx, colorPaletteUUID)
+}
+
+// DeleteColorPalette deletes the palette. A palette that is already gone is not an error.
+func (s *ColorPaletteService) DeleteColorPalette(ctx context.Context, colorPaletteUUID string) error {
+	_ = ctx
+	err := apiv1.DeleteColorPaletteV1(s.client, colorPaletteUUID)
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
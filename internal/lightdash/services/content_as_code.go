@@ -0,0 +1,122 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type ContentAsCodeService struct {
+	client *api.Client
+}
+
+func NewContentAsCodeService(client *api.Client) *ContentAsCodeService {
+	return &ContentAsCodeService{client: client}
+}
+
+// ListContent returns all charts and dashboards of the project in the `lightdash download` format.
+func (s *ContentAsCodeService) ListContent(ctx context.Context, projectUUID string) ([]models.ContentAsCode, error) {
+	_ = ctx
+	var contents []models.ContentAsCode
+	for _, contentType := range []models.ContentType{models.CONTENT_TYPE_CHART, models.CONTENT_TYPE_DASHBOARD} {
+		offset := 0
+		for {
+			var page *apiv1.ContentAsCodeV1Results
+			var err error
+			if contentType == models.CONTENT_TYPE_DASHBOARD {
+				page, err = apiv1.GetDashboardsAsCodeV1(s.client, projectUUID, offset)
+			} else {
+				page, err = apiv1.GetChartsAsCodeV1(s.client, projectUUID, offset)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			documents := page.Charts
+			if contentType == models.CONTENT_TYPE_DASHBOARD {
+				documents = page.Dashboards
+			}
+			for _, document := range documents {
+				content, err := models.NewContentAsCode(contentType, document)
+				if err != nil {
+					return nil, err
+				}
+				contents = append(contents, *content)
+			}
+
+			// Move forward by the items received; stop on an empty page or when all items are read.
+			offset += len(documents)
+			if len(documents) == 0 || offset >= page.Total {
+				break
+			}
+		}
+	}
+	return contents, nil
+}
+
+func (s *ContentAsCodeService) UpsertContent(ctx context.Context, projectUUID string, content models.ContentAsCode) (*models.PromotionChanges, error) {
+	_ = ctx
+	if content.Type == models.CONTENT_TYPE_DASHBOARD {
+		return apiv1.UpsertDashboardAsCodeV1(s.client, projectUUID, content)
+	}
+	return apiv1.UpsertChartAsCodeV1(s.client, projectUUID, content)
+}
+
+// ListContentUUIDs returns the UUIDs of the charts and dashboards of the project,
+// keyed like ContentAsCode.Key, such as `chart/weekly-orders`.
+func (s *ContentAsCodeService) ListContentUUIDs(ctx context.Context, projectUUID string) (map[string][]string, error) {
+	_ = ctx
+	uuids := map[string][]string{}
+	charts, err := apiv1.ListChartsInProjectV1(s.client, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, chart := range charts {
+		key := models.ContentAsCode{Type: models.CONTENT_TYPE_CHART, Slug: chart.Slug}.Key()
+		uuids[key] = append(uuids[key], chart.UUID)
+	}
+
+	dashboards, err := apiv1.ListDashboardsInProjectV1(s.client, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	for _, dashboard := range dashboards {
+		key := models.ContentAsCode{Type: models.CONTENT_TYPE_DASHBOARD, Slug: dashboard.Slug}.Key()
+		uuids[key] = append(uuids[key], dashboard.UUID)
+	}
+	return uuids, nil
+}
+
+// DeleteContent deletes the chart or dashboard with the given UUID.
+// Content that no longer exists is not an error.
+func (s *ContentAsCodeService) DeleteContent(ctx context.Context, contentType models.ContentType, contentUUID string) error {
+	_ = ctx
+	var err error
+	if contentType == models.CONTENT_TYPE_DASHBOARD {
+		err = apiv1.DeleteDashboardV1(s.client, contentUUID)
+	} else {
+		err = apiv1.DeleteSavedChartV1(s.client, contentUUID)
+	}
+	if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+		return fmt.Errorf("failed to delete %s %s: %w", contentType, contentUUID, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+var ErrNoUpstreamProject = errors.New("project has no upstream project")
+
+type ContentPromotionService struct {
+	client *api.Client
+}
+
+func NewContentPromotionService(client *api.Client) *ContentPromotionService {
+	return &ContentPromotionService{client: client}
+}
+
+// ContentPromotionResult describes a completed promotion.
+type ContentPromotionResult struct {
+	UpstreamProjectUUID string
+	PromotedUUID        string
+	Changes             models.PromotionChanges
+}
+
+// GetUpstreamProjectUUID returns the upstream project of the project, or ErrNoUpstreamProject.
+func (s *ContentPromotionService) GetUpstreamProjectUUID(ctx context.Context, projectUUID string) (string, error) {
+	upstream, err := NewProjectUpstreamService(s.client, projectUUID).GetProjectUpstream(ctx)
+	if err != nil {
+		return "", err
+	}
+	if upstream == nil {
+		return "", fmt.Errorf("%w: project UUID %q", ErrNoUpstreamProject, projectUUID)
+	}
+	return *upstream, nil
+}
+
+// Promote copies the chart or dashboard to the upstream project.
+// The changes are read before promoting, since the promote endpoints only return the promoted content.
+func (s *ContentPromotionService) Promote(ctx context.Context, projectUUID string, contentType models.ContentType, contentUUID string) (*ContentPromotionResult, error) {
+	upstreamProjectUUID, err := s.GetUpstreamProjectUUID(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ContentPromotionResult{UpstreamProjectUUID: upstreamProjectUUID}
+	switch contentType {
+	case models.CONTENT_TYPE_CHART:
+		changes, err := apiv1.GetChartPromotionDiffV1(s.client, contentUUID)
+		if err != nil {
+			return nil, err
+		}
+		promoted, err := apiv1.PromoteChartV1(s.client, contentUUID)
+		if err != nil {
+			return nil, err
+		}
+		result.Changes = *changes
+		result.PromotedUUID = promoted.UUID
+	case models.CONTENT_TYPE_DASHBOARD:
+		changes, err := apiv1.GetDashboardPromotionDiffV1(s.client, contentUUID)
+		if err != nil {
+			return nil, err
+		}
+		promoted, err := apiv1.PromoteDashboardV1(s.client, contentUUID)
+		if err != nil {
+			return nil, err
+		}
+		result.Changes = *changes
+		result.PromotedUUID = promoted.UUID
+	default:
+		return nil, fmt.Errorf("content type %q cannot be promoted", contentType)
+	}
+
+	return result, nil
+}
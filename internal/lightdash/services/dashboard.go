@@ -0,0 +1,67 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrDashboardNotFound = errors.New("dashboard not found")
+
+type DashboardService struct {
+	client *api.Client
+}
+
+func NewDashboardService(client *api.Client) *DashboardService {
+	return &DashboardService{client: client}
+}
+
+func (s *DashboardService) GetDashboard(ctx context.Context, dashboardUUID string) (*apiv1.DashboardV1, error) {
+	_ = ctx
+	dashboard, err := apiv1.GetDashboardV1(s.client, dashboardUUID)
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: dashboard UUID %q", ErrDashboardNotFound, dashboardUUID)
+		}
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+func (s *DashboardService) CreateDashboard(ctx context.Context, projectUUID string, request apiv1.CreateDashboardV1Request) (*apiv1.DashboardV1, error) {
+	_ = ctx
+	return apiv1.CreateDashboardV1(s.client, projectUUID, request)
+}
+
+func (s *DashboardService) UpdateDashboard(ctx context.Context, dashboardUUID string, request apiv1.UpdateDashboardV1Request) (*apiv1.DashboardV1, error) {
+	_ = ctx
+	return apiv1.UpdateDashboardV1(s.client, dashboardUUID, request)
+}
+
+// DeleteDashboard deletes the dashboard. A dashboard that is already gone is not an error.
+func (s *DashboardService) DeleteDashboard(ctx context.Context, dashboardUUID string) error {
+	_ = ctx
+	err := apiv1.DeleteDashboardV1(s.client, dashboardUUID)
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
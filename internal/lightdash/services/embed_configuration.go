@@ -0,0 +1,83 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrEmbedConfigurationNotFound = errors.New("embed configuration not found")
+
+type EmbedConfigurationService struct {
+	client *api.Client
+}
+
+func NewEmbedConfigurationService(client *api.Client) *EmbedConfigurationService {
+	return &EmbedConfigurationService{client: client}
+}
+
+func (s *EmbedConfigurationService) GetEmbedConfiguration(ctx context.Context, projectUUID string) (*apiv1.EmbedConfigV1, error) {
+	_ = ctx
+	config, err := apiv1.GetEmbedConfigV1(s.client, projectUUID)
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: project UUID %q", ErrEmbedConfigurationNotFound, projectUUID)
+		}
+		return nil, err
+	}
+	return config, nil
+}
+
+// CreateEmbedConfiguration creates the embedding configuration with a new secret, replacing the secret of an existing one.
+func (s *EmbedConfigurationService) CreateEmbedConfiguration(ctx context.Context, projectUUID string, content apiv1.EmbedContentV1) (*apiv1.EmbedConfigV1, error) {
+	_ = ctx
+	return apiv1.CreateEmbedConfigV1(s.client, projectUUID, normalizeEmbedContent(content))
+}
+
+// UpdateEmbedContent replaces the embeddable content and returns the resulting configuration.
+func (s *EmbedConfigurationService) UpdateEmbedContent(ctx context.Context, projectUUID string, content apiv1.EmbedContentV1) (*apiv1.EmbedConfigV1, error) {
+	if err := apiv1.UpdateEmbedConfigV1(s.client, projectUUID, normalizeEmbedContent(content)); err != nil {
+		return nil, err
+	}
+	return s.GetEmbedConfiguration(ctx, projectUUID)
+}
+
+// DisableEmbedConfiguration removes all embeddable content. Lightdash has no API to delete the configuration itself,
+// so the secret is kept but no longer gives access to anything.
+func (s *EmbedConfigurationService) DisableEmbedConfiguration(ctx context.Context, projectUUID string) error {
+	_ = ctx
+	err := apiv1.UpdateEmbedConfigV1(s.client, projectUUID, normalizeEmbedContent(apiv1.EmbedContentV1{}))
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
+
+// normalizeEmbedContent sends empty lists rather than nulls, which the API rejects.
+func normalizeEmbedContent(content apiv1.EmbedContentV1) apiv1.EmbedContentV1 {
+	if content.DashboardUUIDs == nil {
+		content.DashboardUUIDs = []string{}
+	}
+	if content.ChartUUIDs == nil {
+		content.ChartUUIDs = []string{}
+	}
+	return content
+}
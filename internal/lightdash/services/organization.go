@@ -30,3 +30,21 @@ func GetOrganizationUUID(ctx context.Context, client *api.Client) (string, error
 	}
 	return org.OrganizationUUID, nil
 }
+
+// GetOrganization returns the organization of the configured API token with its settings.
+func GetOrganization(ctx context.Context, client *api.Client) (*apiv1.GetMyOrganizationV1Results, error) {
+	_ = ctx
+	org, err := apiv1.GetMyOrganizationV1(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return org, nil
+}
+
+// UpdateOrganization updates the organization settings and returns the organization as read back from Lightdash.
+func UpdateOrganization(ctx context.Context, client *api.Client, request apiv1.UpdateMyOrganizationV1Request) (*apiv1.GetMyOrganizationV1Results, error) {
+	if err := apiv1.UpdateMyOrganizationV1(client, request); err != nil {
+		return nil, fmt.Errorf("failed to update organization: %w", err)
+	}
+	return GetOrganization(ctx, client)
+}
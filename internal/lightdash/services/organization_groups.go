@@ -16,7 +16,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -24,6 +26,8 @@ import (
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
+var ErrOrganizationGroupNotFound = errors.New("organization group not found")
+
 type OrganizationGroupsService struct {
 	client *api.Client
 }
@@ -39,6 +43,9 @@ func (s *OrganizationGroupsService) GetGroup(ctx context.Context, groupUUID stri
 	// Get the group from the API
 	group, err := apiv1.GetGroupV1(s.client, groupUUID)
 	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: group UUID %q", ErrOrganizationGroupNotFound, groupUUID)
+		}
 		return nil, fmt.Errorf("failed to get group with UUID %s: %w", groupUUID, err)
 	}
 
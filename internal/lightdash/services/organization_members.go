@@ -16,10 +16,13 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
@@ -27,20 +30,24 @@ import (
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
+var ErrOrganizationMemberNotFound = errors.New("organization member not found")
+
 // Package-level variables for the singleton instance and sync.Once
 var organizationMembersServiceInstance *OrganizationMembersService
 var once sync.Once
 
 type OrganizationMembersService struct {
 	client *api.Client
+	// membersMutex guards members, which resources read and invalidate concurrently
+	membersMutex sync.RWMutex
 	// members are cached results from GetOrganizationMembers
 	members []apiv1.GetOrganizationMembersV1Results
 }
 
 // GetOrganizationMembersService returns the singleton instance of OrganizationMembersService.
 // It initializes the instance the first first time it is called in a thread-safe manner.
-// We don't add and remove members in the terraform provider at the moment.
-// So, we can cache the results of GetOrganizationMembers.
+// The results of GetOrganizationMembers are cached. Methods that add or remove members
+// invalidate the cache.
 func GetOrganizationMembersService(client *api.Client) *OrganizationMembersService {
 	once.Do(func() {
 		organizationMembersServiceInstance = &OrganizationMembersService{
@@ -88,16 +95,23 @@ func (s *OrganizationMembersService) GetOrganizationMembers(ctx context.Context)
 // If the members list is already populated, it returns the cached results
 func (s *OrganizationMembersService) GetOrganizationMembersByCache(ctx context.Context) ([]apiv1.GetOrganizationMembersV1Results, error) {
 	// Check if the members list is already populated
-	if len(s.members) == 0 {
-		// Fetch the members from the organization using the API client
-		members, err := s.GetOrganizationMembers(ctx)
-		if err != nil {
-			return nil, err
-		}
-		s.members = members
+	s.membersMutex.RLock()
+	cached := s.members
+	s.membersMutex.RUnlock()
+	if len(cached) > 0 {
+		return cached, nil
+	}
+
+	// Fetch the members from the organization using the API client
+	members, err := s.GetOrganizationMembers(ctx)
+	if err != nil {
+		return nil, err
 	}
+	s.membersMutex.Lock()
+	s.members = members
+	s.membersMutex.Unlock()
 	// Return the list of members
-	return s.members, nil
+	return members, nil
 }
 
 // GetOrganizationAdmins retrieves the admins of an organization.
@@ -169,3 +183,65 @@ func (s *OrganizationMembersService) IsOrganizationAdmin(ctx context.Context, us
 	// If the member is not an admin, return false
 	return false, nil
 }
+
+// InvalidateCache drops the cached members, so that the next cached read fetches them again.
+func (s *OrganizationMembersService) InvalidateCache() {
+	s.membersMutex.Lock()
+	defer s.membersMutex.Unlock()
+	s.members = []apiv1.GetOrganizationMembersV1Results{}
+}
+
+// GetOrganizationMember reads the member from Lightdash without using the cache.
+func (s *OrganizationMembersService) GetOrganizationMember(ctx context.Context, userUuid string) (*apiv1.GetOrganizationMembersV1Results, error) {
+	_ = ctx
+	member, err := apiv1.GetOrganizationMemberByUuidV1(s.client, userUuid)
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: user UUID %q", ErrOrganizationMemberNotFound, userUuid)
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+// FindOrganizationMemberByEmail searches Lightdash for a member with the exact email without using the cache.
+func (s *OrganizationMembersService) FindOrganizationMemberByEmail(ctx context.Context, email string) (*apiv1.GetOrganizationMembersV1Results, error) {
+	_ = ctx
+	members, err := apiv1.GetOrganizationMembersV1(s.client, 0, 100, 0, email)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			return &member, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: email %q", ErrOrganizationMemberNotFound, email)
+}
+
+// InviteOrganizationMember sends an invite by email that expires after the given duration.
+// Inviting a user whose invite is pending sends a new invite.
+func (s *OrganizationMembersService) InviteOrganizationMember(ctx context.Context, email string, role models.OrganizationMemberRole, expiresIn time.Duration) (*apiv1.InviteLinkV1, error) {
+	_ = ctx
+	invite, err := apiv1.CreateInviteLinkV1(s.client, apiv1.CreateInviteLinkV1Request{
+		Email:     email,
+		Role:      role,
+		ExpiresAt: time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.InvalidateCache()
+	return invite, nil
+}
+
+// DeleteOrganizationMember deletes the user. A user that is already gone is not an error.
+func (s *OrganizationMembersService) DeleteOrganizationMember(ctx context.Context, userUuid string) error {
+	_ = ctx
+	err := apiv1.DeleteOrganizationMemberV1(s.client, userUuid)
+	if err != nil && !strings.Contains(err.Error(), "status code: 404") {
+		return err
+	}
+	s.InvalidateCache()
+	return nil
+}
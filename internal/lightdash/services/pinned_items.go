@@ -0,0 +1,154 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+type PinnedItemsService struct {
+	client *api.Client
+}
+
+func NewPinnedItemsService(client *api.Client) *PinnedItemsService {
+	return &PinnedItemsService{client: client}
+}
+
+// GetPinnedListUUID returns the UUID of the project's pinned list, or an empty string
+// if nothing has ever been pinned in the project.
+func (s *PinnedItemsService) GetPinnedListUUID(ctx context.Context, projectUUID string) (string, error) {
+	_ = ctx
+	project, err := apiv1.GetProjectV1(s.client, projectUUID)
+	if err != nil {
+		return "", err
+	}
+	if project.PinnedListUUID == nil {
+		return "", nil
+	}
+	return *project.PinnedListUUID, nil
+}
+
+// GetPinnedItems returns the pinned items of the project in homepage order.
+func (s *PinnedItemsService) GetPinnedItems(ctx context.Context, projectUUID string) (string, []models.PinnedItem, error) {
+	pinnedListUUID, err := s.GetPinnedListUUID(ctx, projectUUID)
+	if err != nil {
+		return "", nil, err
+	}
+	if pinnedListUUID == "" {
+		return "", []models.PinnedItem{}, nil
+	}
+
+	results, err := apiv1.GetPinnedItemsV1(s.client, projectUUID, pinnedListUUID)
+	if err != nil {
+		return "", nil, err
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Data.PinnedListOrder < results[j].Data.PinnedListOrder
+	})
+
+	items := make([]models.PinnedItem, 0, len(results))
+	for _, result := range results {
+		items = append(items, models.PinnedItem{
+			Type: models.PinnedItemType(result.Type),
+			UUID: result.Data.UUID,
+		})
+	}
+	return pinnedListUUID, items, nil
+}
+
+// SetPinnedItems pins and unpins items so that the project homepage shows exactly the
+// desired items in the given order, and returns the pinned list UUID.
+func (s *PinnedItemsService) SetPinnedItems(ctx context.Context, projectUUID string, desired []models.PinnedItem) (string, error) {
+	_, current, err := s.GetPinnedItems(ctx, projectUUID)
+	if err != nil {
+		return "", err
+	}
+
+	toPin, toUnpin := models.DiffPinnedItems(current, desired)
+	for _, item := range toUnpin {
+		if err := s.setPinned(projectUUID, item, false); err != nil {
+			return "", err
+		}
+	}
+	for _, item := range toPin {
+		if err := s.setPinned(projectUUID, item, true); err != nil {
+			return "", err
+		}
+	}
+
+	pinnedListUUID, err := s.GetPinnedListUUID(ctx, projectUUID)
+	if err != nil {
+		return "", err
+	}
+	if pinnedListUUID == "" || len(desired) == 0 {
+		return pinnedListUUID, nil
+	}
+
+	order := make([]apiv1.UpdatePinnedItemOrderV1, 0, len(desired))
+	for i, item := range desired {
+		order = append(order, apiv1.UpdatePinnedItemOrderV1{
+			Type: item.Type.String(),
+			Data: apiv1.UpdatePinnedItemOrderDataV1{
+				UUID:            item.UUID,
+				PinnedListUUID:  pinnedListUUID,
+				PinnedListOrder: int64(i),
+			},
+		})
+	}
+	if err := apiv1.UpdatePinnedItemsOrderV1(s.client, projectUUID, pinnedListUUID, order); err != nil {
+		return "", err
+	}
+	return pinnedListUUID, nil
+}
+
+// UnpinItems unpins the given items. Items that are no longer pinned are left alone.
+func (s *PinnedItemsService) UnpinItems(ctx context.Context, projectUUID string, items []models.PinnedItem) error {
+	_, current, err := s.GetPinnedItems(ctx, projectUUID)
+	if err != nil {
+		return err
+	}
+	pinned := make(map[models.PinnedItem]bool, len(current))
+	for _, item := range current {
+		pinned[item] = true
+	}
+	for _, item := range items {
+		if !pinned[item] {
+			continue
+		}
+		if err := s.setPinned(projectUUID, item, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPinned toggles the pinning of the item and checks that it ended up in the wanted state,
+// since the Lightdash API only offers a toggle.
+func (s *PinnedItemsService) setPinned(projectUUID string, item models.PinnedItem, pinned bool) error {
+	result, err := apiv1.TogglePinningV1(s.client, projectUUID, item)
+	if err != nil {
+		return err
+	}
+	if result.IsPinned != pinned {
+		return fmt.Errorf("%s %q ended up with pinned=%t after toggling, expected pinned=%t", item.Type, item.UUID, result.IsPinned, pinned)
+	}
+	return nil
+}
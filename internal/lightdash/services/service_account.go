@@ -0,0 +1,80 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrServiceAccountNotFound = errors.New("service account not found")
+
+type ServiceAccountService struct {
+	client *api.Client
+}
+
+func NewServiceAccountService(client *api.Client) *ServiceAccountService {
+	return &ServiceAccountService{client: client}
+}
+
+// GetServiceAccount looks the service account up in the service accounts of the organization.
+func (s *ServiceAccountService) GetServiceAccount(ctx context.Context, serviceAccountUUID string) (*apiv1.ServiceAccountV1, error) {
+	_ = ctx
+	serviceAccounts, err := apiv1.ListServiceAccountsV1(s.client)
+	if err != nil {
+		return nil, err
+	}
+	for i := range serviceAccounts {
+		if serviceAccounts[i].UUID == serviceAccountUUID {
+			return &serviceAccounts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: service account UUID %q", ErrServiceAccountNotFound, serviceAccountUUID)
+}
+
+// CreateServiceAccount creates a service account whose token expires after the given duration, or never if it is zero.
+func (s *ServiceAccountService) CreateServiceAccount(ctx context.Context, description string, scopes []string, expiresIn time.Duration) (*apiv1.ServiceAccountWithTokenV1, error) {
+	_ = ctx
+	request := apiv1.CreateServiceAccountV1Request{Description: description, Scopes: scopes}
+	if expiresIn > 0 {
+		expiresAt := time.Now().Add(expiresIn).UTC().Format(time.RFC3339)
+		request.ExpiresAt = &expiresAt
+	}
+	return apiv1.CreateServiceAccountV1(s.client, request)
+}
+
+// RotateServiceAccount replaces the token of the service account with one that expires after the given duration.
+func (s *ServiceAccountService) RotateServiceAccount(ctx context.Context, serviceAccountUUID string, expiresIn time.Duration) (*apiv1.ServiceAccountWithTokenV1, error) {
+	_ = ctx
+	return apiv1.RotateServiceAccountV1(s.client, serviceAccountUUID, apiv1.RotateServiceAccountV1Request{
+		ExpiresAt: time.Now().Add(expiresIn).UTC().Format(time.RFC3339),
+	})
+}
+
+// DeleteServiceAccount deletes the service account and revokes its token. A service account that is already gone is not an error.
+func (s *ServiceAccountService) DeleteServiceAccount(ctx context.Context, serviceAccountUUID string) error {
+	_ = ctx
+	err := apiv1.DeleteServiceAccountV1(s.client, serviceAccountUUID)
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
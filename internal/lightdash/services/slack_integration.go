@@ -0,0 +1,87 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrSlackNotInstalled = errors.New("the Slack app is not installed in the organization")
+
+// SlackSettings are the custom settings of the Slack app that can be managed.
+type SlackSettings struct {
+	NotificationChannel   *string
+	AppProfilePhotoURL    *string
+	AIThreadAccessConsent *bool
+	AIRequireOAuth        *bool
+	AIMultiAgentChannelID *string
+}
+
+type SlackIntegrationService struct {
+	client *api.Client
+}
+
+func NewSlackIntegrationService(client *api.Client) *SlackIntegrationService {
+	return &SlackIntegrationService{client: client}
+}
+
+func (s *SlackIntegrationService) GetSlackSettings(ctx context.Context) (*apiv1.SlackSettingsV1, error) {
+	_ = ctx
+	settings, err := apiv1.GetSlackSettingsV1(s.client)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, ErrSlackNotInstalled
+	}
+	return settings, nil
+}
+
+// UpdateSlackSettings replaces the managed settings. Boolean settings left nil keep their current value,
+// and the channel project mappings, which are not managed here, are sent back as is.
+func (s *SlackIntegrationService) UpdateSlackSettings(ctx context.Context, settings SlackSettings) (*apiv1.SlackSettingsV1, error) {
+	current, err := s.GetSlackSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.AIThreadAccessConsent == nil {
+		settings.AIThreadAccessConsent = current.AIThreadAccessConsent
+	}
+	if settings.AIRequireOAuth == nil {
+		settings.AIRequireOAuth = current.AIRequireOAuth
+	}
+	request := apiv1.UpdateSlackCustomSettingsV1Request{
+		NotificationChannel:    settings.NotificationChannel,
+		AppProfilePhotoURL:     settings.AppProfilePhotoURL,
+		AIThreadAccessConsent:  settings.AIThreadAccessConsent,
+		AIRequireOAuth:         settings.AIRequireOAuth,
+		AIMultiAgentChannelID:  settings.AIMultiAgentChannelID,
+		ChannelProjectMappings: current.ChannelProjectMappings,
+	}
+	if err := apiv1.UpdateSlackCustomSettingsV1(s.client, request); err != nil {
+		return nil, err
+	}
+	return s.GetSlackSettings(ctx)
+}
+
+func (s *SlackIntegrationService) ListSlackChannels(ctx context.Context, search string, excludeArchived bool) ([]apiv1.SlackChannelV1, error) {
+	_ = ctx
+	return apiv1.ListSlackChannelsV1(s.client, search, excludeArchived)
+}
@@ -16,7 +16,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 	apiv2 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v2"
@@ -27,6 +29,8 @@ import (
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
+var ErrSpaceNotFound = errors.New("space not found")
+
 // SpaceService provides methods for managing Lightdash spaces.
 type SpaceService struct {
 	client *api.Client
@@ -107,7 +111,23 @@ func (s *SpaceService) CreateSpace(ctx context.Context, projectUuid, spaceName s
 
 // GetSpace retrieves a space by UUID
 func (s *SpaceService) GetSpace(ctx context.Context, projectUuid, spaceUuid string) (*apiv1.GetSpaceV1Results, error) {
-	return apiv1.GetSpaceV1(s.client, projectUuid, spaceUuid)
+	space, err := apiv1.GetSpaceV1(s.client, projectUuid, spaceUuid)
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: space UUID %q", ErrSpaceNotFound, spaceUuid)
+		}
+		return nil, err
+	}
+	return space, nil
+}
+
+// ListSpaces lists the spaces of a project
+func (s *SpaceService) ListSpaces(ctx context.Context, projectUuid string) ([]apiv1.ListSpacesInProjectV1Results, error) {
+	spaces, err := apiv1.ListSpacesInProjectV1(s.client, projectUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list spaces: %w", err)
+	}
+	return spaces, nil
 }
 
 // UpdateRootSpace updates the space properties for a root space
@@ -158,6 +178,96 @@ func (s *SpaceService) MoveSpace(ctx context.Context, projectUuid, spaceUuid str
 	return nil
 }
 
+// MoveContent moves a saved chart or a dashboard into the target space.
+// SQL runner charts are moved with MoveSQLChart.
+func (s *SpaceService) MoveContent(ctx context.Context, projectUuid string, contentType models.ContentType, contentUuid, targetSpaceUuid string) error {
+	item := apiv2.MoveContentV2Item{
+		UUID:        contentUuid,
+		ContentType: contentType.String(),
+	}
+	if contentType == models.CONTENT_TYPE_CHART {
+		source := "dbt_explore"
+		item.Source = &source
+	}
+	err := apiv2.MoveContentV2(s.client, projectUuid, item, targetSpaceUuid)
+	if err != nil {
+		return fmt.Errorf("failed to move %s: %w", contentType, err)
+	}
+	return nil
+}
+
+// MoveSQLChart moves a SQL runner chart into the target space
+func (s *SpaceService) MoveSQLChart(ctx context.Context, projectUuid, chartUuid, targetSpaceUuid string) error {
+	source := "sql"
+	item := apiv2.MoveContentV2Item{
+		UUID:        chartUuid,
+		ContentType: models.CONTENT_TYPE_CHART.String(),
+		Source:      &source,
+	}
+	err := apiv2.MoveContentV2(s.client, projectUuid, item, targetSpaceUuid)
+	if err != nil {
+		return fmt.Errorf("failed to move SQL chart: %w", err)
+	}
+	return nil
+}
+
+// ListSpaceSQLCharts returns the SQL runner charts stored directly in a space.
+// Saved charts are listed by GetSpace and are left out.
+func (s *SpaceService) ListSpaceSQLCharts(ctx context.Context, projectUuid, spaceUuid string) ([]models.SpaceContent, error) {
+	items, err := apiv2.ListSpaceContentV2(s.client, projectUuid, spaceUuid, models.CONTENT_TYPE_CHART.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SQL charts: %w", err)
+	}
+	charts := []models.SpaceContent{}
+	for _, item := range items {
+		if item.Source == nil || *item.Source != "sql" {
+			continue
+		}
+		charts = append(charts, models.SpaceContent{
+			UUID:        item.UUID,
+			Name:        item.Name,
+			ContentType: models.CONTENT_TYPE_CHART,
+			SQLChart:    true,
+		})
+	}
+	return charts, nil
+}
+
+// ListContentSpaces returns the location of every chart or dashboard in the project, keyed by content UUID.
+// Charts include the SQL runner charts.
+func (s *SpaceService) ListContentSpaces(ctx context.Context, projectUuid string, contentType models.ContentType) (map[string]models.ContentLocation, error) {
+	spaces := map[string]models.ContentLocation{}
+	if contentType == models.CONTENT_TYPE_DASHBOARD {
+		dashboards, err := apiv1.ListDashboardsInProjectV1(s.client, projectUuid)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list dashboards: %w", err)
+		}
+		for _, dashboard := range dashboards {
+			spaces[dashboard.UUID] = models.ContentLocation{SpaceUUID: dashboard.SpaceUUID}
+		}
+		return spaces, nil
+	}
+
+	charts, err := apiv1.ListChartsInProjectV1(s.client, projectUuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list charts: %w", err)
+	}
+	for _, chart := range charts {
+		spaces[chart.UUID] = models.ContentLocation{SpaceUUID: chart.SpaceUUID}
+	}
+
+	items, err := apiv2.ListProjectContentV2(s.client, projectUuid, models.CONTENT_TYPE_CHART.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SQL charts: %w", err)
+	}
+	for _, item := range items {
+		if item.Source != nil && *item.Source == "sql" {
+			spaces[item.UUID] = models.ContentLocation{SpaceUUID: item.Space.UUID, SQLChart: true}
+		}
+	}
+	return spaces, nil
+}
+
 // Resource ID Handling Methods
 
 // GetSpaceResourceID returns the formatted resource ID for a space
@@ -0,0 +1,74 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+var ErrSQLChartNotFound = errors.New("SQL chart not found")
+
+type SQLChartService struct {
+	client *api.Client
+}
+
+func NewSQLChartService(client *api.Client) *SQLChartService {
+	return &SQLChartService{client: client}
+}
+
+func (s *SQLChartService) GetSQLChart(ctx context.Context, projectUUID string, savedSQLUUID string) (*apiv1.SQLChartV1, error) {
+	_ = ctx
+	chart, err := apiv1.GetSQLChartV1(s.client, projectUUID, savedSQLUUID)
+	if err != nil {
+		if strings.Contains(err.Error(), "status code: 404") {
+			return nil, fmt.Errorf("%w: saved SQL UUID %q", ErrSQLChartNotFound, savedSQLUUID)
+		}
+		return nil, err
+	}
+	return chart, nil
+}
+
+// CreateSQLChart creates the chart and returns it as read back from Lightdash.
+func (s *SQLChartService) CreateSQLChart(ctx context.Context, projectUUID string, request apiv1.CreateSQLChartV1Request) (*apiv1.SQLChartV1, error) {
+	created, err := apiv1.CreateSQLChartV1(s.client, projectUUID, request)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetSQLChart(ctx, projectUUID, created.SavedSQLUUID)
+}
+
+// UpdateSQLChart updates the chart and returns it as read back from Lightdash.
+func (s *SQLChartService) UpdateSQLChart(ctx context.Context, projectUUID string, savedSQLUUID string, request apiv1.UpdateSQLChartV1Request) (*apiv1.SQLChartV1, error) {
+	if _, err := apiv1.UpdateSQLChartV1(s.client, projectUUID, savedSQLUUID, request); err != nil {
+		return nil, err
+	}
+	return s.GetSQLChart(ctx, projectUUID, savedSQLUUID)
+}
+
+// DeleteSQLChart deletes the chart. A chart that is already gone is not an error.
+func (s *SQLChartService) DeleteSQLChart(ctx context.Context, projectUUID string, savedSQLUUID string) error {
+	_ = ctx
+	err := apiv1.DeleteSQLChartV1(s.client, projectUUID, savedSQLUUID)
+	if err != nil && strings.Contains(err.Error(), "status code: 404") {
+		return nil
+	}
+	return err
+}
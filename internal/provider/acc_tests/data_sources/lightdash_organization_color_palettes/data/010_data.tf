@@ -0,0 +1,16 @@
+data "lightdash_organization" "test" {
+}
+
+// Guarantee that a palette exists before the data source is read
+resource "lightdash_organization_color_palette" "test" {
+  name   = "Palette (Acceptance Test: data_source_lightdash_organization_color_palettes)"
+  colors = ["#1A1A2E", "#16213E"]
+}
+
+data "lightdash_organization_color_palettes" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+
+  depends_on = [
+    lightdash_organization_color_palette.test,
+  ]
+}
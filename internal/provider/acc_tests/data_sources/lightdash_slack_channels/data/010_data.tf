@@ -0,0 +1,6 @@
+data "lightdash_organization" "test" {
+}
+
+data "lightdash_slack_channels" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+}
@@ -0,0 +1,37 @@
+data "lightdash_organization" "test" {
+}
+
+resource "lightdash_space" "spaces__root" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Spaces Root (Acceptance Test: data_source_lightdash_spaces)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_space" "spaces__child" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Spaces Child (Acceptance Test: data_source_lightdash_spaces)"
+  parent_space_uuid   = lightdash_space.spaces__root.space_uuid
+  deletion_protection = false
+}
+
+data "lightdash_spaces" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  project_uuid      = data.lightdash_project.test.project_uuid
+  name_regex        = "\\(Acceptance Test: data_source_lightdash_spaces\\)$"
+  include_tree      = true
+
+  depends_on = [
+    lightdash_space.spaces__child,
+  ]
+}
+
+data "lightdash_spaces" "children" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  project_uuid      = data.lightdash_project.test.project_uuid
+  parent_space_uuid = lightdash_space.spaces__root.space_uuid
+
+  depends_on = [
+    lightdash_space.spaces__child,
+  ]
+}
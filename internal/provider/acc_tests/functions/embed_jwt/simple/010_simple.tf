@@ -0,0 +1,8 @@
+output "embed_jwt" {
+  value = provider::lightdash::embed_jwt(
+    "embed-secret",
+    "dashboard-uuid",
+    { country = "JP" },
+    "2030-01-01T00:00:00Z"
+  )
+}
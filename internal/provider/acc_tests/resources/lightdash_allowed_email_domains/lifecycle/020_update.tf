@@ -0,0 +1,15 @@
+data "lightdash_organization" "test" {
+}
+
+resource "lightdash_allowed_email_domains" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  email_domains     = ["acceptance-test.example.com"]
+  role              = "member"
+
+  projects = [
+    {
+      project_uuid = data.lightdash_project.test.project_uuid
+      role         = "viewer"
+    },
+  ]
+}
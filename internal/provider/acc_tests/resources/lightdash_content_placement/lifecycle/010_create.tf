@@ -0,0 +1,43 @@
+resource "lightdash_space" "content_placement__source" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Source Space (Acceptance Test: content_placement resource)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_space" "content_placement__target" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Target Space (Acceptance Test: content_placement resource)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_dashboard" "content_placement__test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.content_placement__source.space_uuid
+  name         = "Dashboard (Acceptance Test: content_placement resource)"
+
+  tiles = [
+    {
+      type    = "markdown"
+      x       = 0
+      y       = 0
+      width   = 36
+      height  = 3
+      title   = "Notes"
+      content = "Placed by the acceptance test."
+    },
+  ]
+
+  deletion_protection = false
+
+  lifecycle {
+    ignore_changes = [space_uuid]
+  }
+}
+
+resource "lightdash_content_placement" "test" {
+  project_uuid    = data.lightdash_project.test.project_uuid
+  space_uuid      = lightdash_space.content_placement__target.space_uuid
+  dashboard_uuids = [lightdash_dashboard.content_placement__test.dashboard_uuid]
+}
@@ -0,0 +1,32 @@
+resource "lightdash_content_sync" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+
+  documents = [
+    yamlencode({
+      version     = 1
+      name        = "Content Sync Dashboard (Acceptance Test: content sync)"
+      description = "Uploaded by the acceptance test"
+      slug        = "acceptance-test-content-sync"
+      spaceSlug   = "acceptance-test-content-sync"
+      tabs        = []
+      filters = {
+        dimensions        = []
+        metrics           = []
+        tableCalculations = []
+      }
+      tiles = [
+        {
+          type = "markdown"
+          x    = 0
+          y    = 0
+          w    = 36
+          h    = 3
+          properties = {
+            title   = "Notes"
+            content = "Uploaded by the acceptance test."
+          }
+        },
+      ]
+    }),
+  ]
+}
@@ -0,0 +1,29 @@
+resource "lightdash_space" "dashboard_import" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Dashboard Space (Acceptance Test: dashboard import)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_dashboard" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.dashboard_import.space_uuid
+  name         = "Dashboard (Acceptance Test: dashboard import)"
+
+  tabs = ["Summary"]
+
+  tiles = [
+    {
+      type    = "markdown"
+      tab     = "Summary"
+      x       = 0
+      y       = 0
+      width   = 18
+      height  = 3
+      title   = "Notes"
+      content = "Imported by the acceptance test."
+    },
+  ]
+
+  deletion_protection = false
+}
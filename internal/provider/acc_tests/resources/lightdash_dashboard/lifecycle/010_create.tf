@@ -0,0 +1,26 @@
+resource "lightdash_space" "dashboard_lifecycle" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Dashboard Space (Acceptance Test: dashboard lifecycle)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_dashboard" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.dashboard_lifecycle.space_uuid
+  name         = "Dashboard (Acceptance Test: dashboard lifecycle)"
+
+  tiles = [
+    {
+      type    = "markdown"
+      x       = 0
+      y       = 0
+      width   = 36
+      height  = 3
+      title   = "Notes"
+      content = "Created by the acceptance test."
+    },
+  ]
+
+  deletion_protection = false
+}
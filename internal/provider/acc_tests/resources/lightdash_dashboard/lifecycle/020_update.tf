@@ -0,0 +1,39 @@
+resource "lightdash_space" "dashboard_lifecycle" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Dashboard Space (Acceptance Test: dashboard lifecycle)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_dashboard" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.dashboard_lifecycle.space_uuid
+  name         = "Dashboard (Acceptance Test: dashboard lifecycle updated)"
+  description  = "Updated by the acceptance test."
+
+  tabs = ["Summary", "Video"]
+
+  tiles = [
+    {
+      type   = "loom"
+      tab    = "Video"
+      x      = 0
+      y      = 0
+      width  = 36
+      height = 12
+      url    = "https://www.loom.com/share/0123456789abcdef"
+    },
+    {
+      type    = "markdown"
+      tab     = "Summary"
+      x       = 0
+      y       = 0
+      width   = 36
+      height  = 3
+      title   = "Notes"
+      content = "Updated by the acceptance test."
+    },
+  ]
+
+  deletion_protection = false
+}
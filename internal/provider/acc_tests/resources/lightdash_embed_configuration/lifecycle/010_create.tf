@@ -0,0 +1,5 @@
+resource "lightdash_embed_configuration" "test" {
+  project_uuid            = data.lightdash_project.test.project_uuid
+  allow_all_dashboards    = true
+  secret_rotation_trigger = "1"
+}
@@ -0,0 +1,6 @@
+resource "lightdash_embed_configuration" "test" {
+  project_uuid            = data.lightdash_project.test.project_uuid
+  allow_all_dashboards    = false
+  allow_all_charts        = true
+  secret_rotation_trigger = "2"
+}
@@ -0,0 +1,5 @@
+resource "lightdash_invite_link" "test" {
+  email           = "acceptance-test-invite-link@example.com"
+  role            = "viewer"
+  expiration_days = 1
+}
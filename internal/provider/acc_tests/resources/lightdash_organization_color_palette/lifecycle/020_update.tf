@@ -0,0 +1,4 @@
+resource "lightdash_organization_color_palette" "test" {
+  name   = "Palette (Acceptance Test: color palette lifecycle)"
+  colors = ["#0F3460", "#E94560"]
+}
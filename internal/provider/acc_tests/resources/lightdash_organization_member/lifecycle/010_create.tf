@@ -0,0 +1,9 @@
+data "lightdash_organization" "test" {
+}
+
+resource "lightdash_organization_member" "test" {
+  organization_uuid   = data.lightdash_organization.test.organization_uuid
+  email               = "acceptance-test-organization-member@example.com"
+  role                = "viewer"
+  deletion_protection = false
+}
@@ -0,0 +1,5 @@
+resource "lightdash_personal_access_token" "test" {
+  description     = "acceptance test personal access token"
+  expiration_days = 7
+  rotation_days   = 3
+}
@@ -0,0 +1,28 @@
+resource "lightdash_space" "pinned_items_first" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Pinned Space 1 (Acceptance Test: pinned items lifecycle)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_space" "pinned_items_second" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Pinned Space 2 (Acceptance Test: pinned items lifecycle)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_pinned_items" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+
+  items = [
+    {
+      type = "space"
+      uuid = lightdash_space.pinned_items_second.space_uuid
+    },
+    {
+      type = "space"
+      uuid = lightdash_space.pinned_items_first.space_uuid
+    },
+  ]
+}
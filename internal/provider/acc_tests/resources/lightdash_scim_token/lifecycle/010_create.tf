@@ -0,0 +1,7 @@
+data "lightdash_organization" "test" {}
+
+resource "lightdash_scim_token" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  description       = "acceptance test SCIM token"
+  expiration_days   = 30
+}
@@ -0,0 +1,8 @@
+data "lightdash_organization" "test" {}
+
+resource "lightdash_service_account" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  description       = "acceptance test service account"
+  scopes            = ["org:read"]
+  expiration_days   = 7
+}
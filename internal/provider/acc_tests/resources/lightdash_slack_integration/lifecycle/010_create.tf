@@ -0,0 +1,11 @@
+data "lightdash_organization" "test" {
+}
+
+data "lightdash_slack_channels" "test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+}
+
+resource "lightdash_slack_integration" "test" {
+  organization_uuid       = data.lightdash_organization.test.organization_uuid
+  notification_channel_id = data.lightdash_slack_channels.test.channels[0].channel_id
+}
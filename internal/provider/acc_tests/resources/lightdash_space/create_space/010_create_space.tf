@@ -36,3 +36,13 @@ data "lightdash_space" "create_space__test_private" {
     lightdash_space.create_space__test_private
   ]
 }
+
+data "lightdash_space" "create_space__test_public_by_path" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  project_uuid      = data.lightdash_project.test.project_uuid
+  path              = lightdash_space.create_space__test_public.name
+
+  depends_on = [
+    lightdash_space.create_space__test_public
+  ]
+}
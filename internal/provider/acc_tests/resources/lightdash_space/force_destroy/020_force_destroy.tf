@@ -0,0 +1,22 @@
+resource "lightdash_space" "force_destroy_fallback" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Fallback Space (Acceptance Test: force_destroy)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_space" "force_destroy_root" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Root Space (Acceptance Test: force_destroy)"
+  is_private          = false
+  deletion_protection = false
+
+  force_destroy = "delete"
+}
+
+resource "lightdash_space" "force_destroy_child" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Child Space (Acceptance Test: force_destroy)"
+  deletion_protection = false
+  parent_space_uuid   = lightdash_space.force_destroy_root.space_uuid
+}
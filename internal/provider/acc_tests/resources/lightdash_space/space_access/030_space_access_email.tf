@@ -0,0 +1,23 @@
+data "lightdash_organization" "test" {
+}
+
+data "lightdash_authenticated_user" "test" {
+}
+
+data "lightdash_organization_member" "space_access__test_member" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  user_uuid         = data.lightdash_authenticated_user.test.user_uuid
+}
+
+resource "lightdash_space" "space_access__test_space_1" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Space 1 (Acceptance Test: space_access)"
+  is_private          = true
+  deletion_protection = false
+
+  // Reference the user by email
+  access {
+    email      = data.lightdash_organization_member.space_access__test_member.email
+    space_role = "editor"
+  }
+}
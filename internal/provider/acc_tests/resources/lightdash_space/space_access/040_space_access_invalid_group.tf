@@ -0,0 +1,12 @@
+resource "lightdash_space" "space_access__test_space_1" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Space 1 (Acceptance Test: space_access)"
+  is_private          = true
+  deletion_protection = false
+
+  // The group does not exist, so the plan fails before anything is changed
+  group_access {
+    group_uuid = "00000000-0000-0000-0000-000000000000"
+    space_role = "viewer"
+  }
+}
@@ -0,0 +1,17 @@
+data "lightdash_authenticated_user" "test" {
+}
+
+resource "lightdash_space" "space_access__test" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Renamed Space (Acceptance Test: space_access resource)"
+  is_private          = true
+  deletion_protection = false
+  manage_access       = false
+}
+
+resource "lightdash_space_access" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.space_access__test.space_uuid
+  user_uuid    = data.lightdash_authenticated_user.test.user_uuid
+  space_role   = "editor"
+}
@@ -0,0 +1,23 @@
+data "lightdash_organization" "test" {
+}
+
+resource "lightdash_group" "space_group_access__test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  name              = "Acceptance Test Group (space_group_access)"
+  members           = []
+}
+
+resource "lightdash_space" "space_group_access__test" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "Space (Acceptance Test: space_group_access)"
+  is_private          = true
+  deletion_protection = false
+  manage_access       = false
+}
+
+resource "lightdash_space_group_access" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.space_group_access__test.space_uuid
+  group_uuid   = lightdash_group.space_group_access__test.group_uuid
+  space_role   = "editor"
+}
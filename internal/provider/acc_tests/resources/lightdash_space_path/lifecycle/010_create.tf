@@ -0,0 +1,19 @@
+resource "lightdash_space_path" "test" {
+  project_uuid          = data.lightdash_project.test.project_uuid
+  path                  = "Space Path (Acceptance Test)/Campaigns/2026"
+  is_private            = false
+  delete_created_spaces = true
+}
+
+data "lightdash_organization" "test" {
+}
+
+data "lightdash_space" "space_path__test" {
+  organization_uuid = data.lightdash_organization.test.organization_uuid
+  project_uuid      = data.lightdash_project.test.project_uuid
+  path              = lightdash_space_path.test.path
+
+  depends_on = [
+    lightdash_space_path.test
+  ]
+}
@@ -0,0 +1,18 @@
+resource "lightdash_space_path" "test" {
+  project_uuid          = data.lightdash_project.test.project_uuid
+  path                  = "Space Path (Acceptance Test)/Campaigns/2026"
+  is_private            = false
+  delete_created_spaces = true
+}
+
+// An overlapping path reuses the existing spaces and only creates the last one
+resource "lightdash_space_path" "overlap" {
+  project_uuid          = data.lightdash_project.test.project_uuid
+  path                  = "Space Path (Acceptance Test)/Campaigns/2027"
+  delete_created_spaces = true
+
+  // Destroy the nested space before the spaces of the other path
+  depends_on = [
+    lightdash_space_path.test
+  ]
+}
@@ -0,0 +1,25 @@
+resource "lightdash_space_tree" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+
+  spaces = [
+    {
+      name       = "Marketing (Acceptance Test: space_tree)"
+      is_private = false
+      children = [
+        {
+          key  = "campaigns"
+          name = "Campaigns"
+        },
+        {
+          key  = "archive"
+          name = "Archive"
+        },
+      ]
+    },
+    {
+      key        = "sales"
+      name       = "Sales (Acceptance Test: space_tree)"
+      is_private = false
+    },
+  ]
+}
@@ -0,0 +1,26 @@
+resource "lightdash_space" "sql_chart_import" {
+  project_uuid        = data.lightdash_project.test.project_uuid
+  name                = "SQL Chart Space (Acceptance Test: sql chart import)"
+  is_private          = false
+  deletion_protection = false
+}
+
+resource "lightdash_sql_chart" "test" {
+  project_uuid = data.lightdash_project.test.project_uuid
+  space_uuid   = lightdash_space.sql_chart_import.space_uuid
+  name         = "SQL Chart (Acceptance Test: sql chart import)"
+
+  sql = <<-SQL
+    select 1 as value
+  SQL
+
+  config = jsonencode({
+    metadata = {
+      version = 1
+    }
+    type    = "table"
+    columns = {}
+  })
+
+  deletion_protection = false
+}
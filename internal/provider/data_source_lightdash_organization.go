@@ -44,8 +44,12 @@ type organizationDataSource struct {
 
 // LightdashProjectDataSourceModel describes the data source data model.
 type organizationDataSourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	OrganizationUuid types.String `tfsdk:"organization_uuid"`
+	ID                 types.String `tfsdk:"id"`
+	OrganizationUuid   types.String `tfsdk:"organization_uuid"`
+	Name               types.String `tfsdk:"name"`
+	DefaultProjectUUID types.String `tfsdk:"default_project_uuid"`
+	ColorPaletteUUID   types.String `tfsdk:"color_palette_uuid"`
+	ChartColors        types.List   `tfsdk:"chart_colors"`
 }
 
 func (d *organizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -74,6 +78,23 @@ func (d *organizationDataSource) Schema(ctx context.Context, req datasource.Sche
 				MarkdownDescription: "The UUID of the Lightdash organization.",
 				Computed:            true,
 			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Lightdash organization.",
+				Computed:            true,
+			},
+			"default_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the default project of the organization, if any.",
+				Computed:            true,
+			},
+			"color_palette_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the color palette used for charts, if any.",
+				Computed:            true,
+			},
+			"chart_colors": schema.ListAttribute{
+				MarkdownDescription: "The colors used for charts in the organization.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -112,12 +133,18 @@ func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	// Map response body to model
 	state.OrganizationUuid = types.StringValue(organization.OrganizationUUID)
+	state.Name = types.StringValue(organization.Name)
+	state.DefaultProjectUUID = nonEmptyStringValue(organization.DefaultProjectUUID)
+	state.ColorPaletteUUID = nonEmptyStringValue(organization.ColorPaletteUUID)
+	chartColors, diags := types.ListValueFrom(ctx, types.StringType, organization.ChartColors)
+	resp.Diagnostics.Append(diags...)
+	state.ChartColors = chartColors
 
 	// Set resource ID
 	state.ID = types.StringValue(fmt.Sprintf("organizations/%s", organization.OrganizationUUID))
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,176 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &organizationColorPalettesDataSource{}
+	_ datasource.DataSourceWithConfigure = &organizationColorPalettesDataSource{}
+)
+
+func NewOrganizationColorPalettesDataSource() datasource.DataSource {
+	return &organizationColorPalettesDataSource{}
+}
+
+type organizationColorPalettesDataSource struct {
+	client *api.Client
+}
+
+// organizationColorPaletteModel describes a color palette in the data source data model.
+type organizationColorPaletteModel struct {
+	ColorPaletteUUID types.String   `tfsdk:"color_palette_uuid"`
+	Name             types.String   `tfsdk:"name"`
+	Colors           []types.String `tfsdk:"colors"`
+	IsActive         types.Bool     `tfsdk:"is_active"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+}
+
+// organizationColorPalettesDataSourceModel describes the data source data model.
+type organizationColorPalettesDataSourceModel struct {
+	ID               types.String                    `tfsdk:"id"`
+	OrganizationUUID types.String                    `tfsdk:"organization_uuid"`
+	ColorPalettes    []organizationColorPaletteModel `tfsdk:"color_palettes"`
+}
+
+func (d *organizationColorPalettesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_color_palettes"
+}
+
+func (d *organizationColorPalettesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_organization_color_palettes.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash organization color palettes data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `organizations/<organization_uuid>/color_palettes`.",
+				Computed:            true,
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash organization.",
+				Required:            true,
+			},
+			"color_palettes": schema.ListNestedAttribute{
+				MarkdownDescription: "The color palettes of the organization, in the order returned by Lightdash.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"color_palette_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the color palette.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the color palette.",
+							Computed:            true,
+						},
+						"colors": schema.ListAttribute{
+							MarkdownDescription: "The ordered list of hex colors of the palette.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"is_active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the palette is the one used for charts across the organization.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The timestamp when the color palette was created.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *organizationColorPalettesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *organizationColorPalettesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state organizationColorPalettesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationUUID(d.client, state.OrganizationUUID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewColorPaletteService(d.client)
+	palettes, err := service.ListColorPalettes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get organization color palettes", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("organizations/%s/color_palettes", state.OrganizationUUID.ValueString()))
+	state.ColorPalettes = organizationColorPaletteModels(palettes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func organizationColorPaletteModels(palettes []apiv1.ColorPaletteV1) []organizationColorPaletteModel {
+	paletteModels := make([]organizationColorPaletteModel, 0, len(palettes))
+	for _, palette := range palettes {
+		colors := make([]types.String, 0, len(palette.Colors))
+		for _, color := range palette.Colors {
+			colors = append(colors, types.StringValue(color))
+		}
+		paletteModels = append(paletteModels, organizationColorPaletteModel{
+			ColorPaletteUUID: types.StringValue(palette.ColorPaletteUUID),
+			Name:             types.StringValue(palette.Name),
+			Colors:           colors,
+			IsActive:         types.BoolValue(palette.IsActive),
+			CreatedAt:        types.StringValue(palette.CreatedAt),
+		})
+	}
+	return paletteModels
+}
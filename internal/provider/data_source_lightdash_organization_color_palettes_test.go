@@ -0,0 +1,74 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestOrganizationColorPaletteModels(t *testing.T) {
+	t.Parallel()
+
+	got := organizationColorPaletteModels([]apiv1.ColorPaletteV1{
+		{ColorPaletteUUID: "palette-uuid", Name: "Corporate", Colors: []string{"#1A1A2E", "#16213E"}, IsActive: true},
+		{ColorPaletteUUID: "default-uuid", Name: "Default", Colors: []string{}},
+	})
+	if len(got) != 2 {
+		t.Fatalf("expected 2 color palettes, got %d", len(got))
+	}
+	if got[0].ColorPaletteUUID.ValueString() != "palette-uuid" || !got[0].IsActive.ValueBool() {
+		t.Errorf("unexpected first color palette: %+v", got[0])
+	}
+	if len(got[0].Colors) != 2 || got[0].Colors[1].ValueString() != "#16213E" {
+		t.Errorf("expected colors to keep their order, got %v", got[0].Colors)
+	}
+	if got[1].IsActive.ValueBool() {
+		t.Errorf("expected second color palette to be inactive")
+	}
+}
+
+func TestAccOrganizationColorPalettesDataSource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for data_source_lightdash_organization_color_palettes")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	config, err := ReadAccTestResource([]string{"data_sources", "lightdash_organization_color_palettes", "data", "010_data.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get organizationColorPalettesConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lightdash_organization_color_palettes.test", "organization_uuid"),
+					resource.TestCheckResourceAttrSet("data.lightdash_organization_color_palettes.test", "color_palettes.0.color_palette_uuid"),
+					resource.TestCheckResourceAttrSet("data.lightdash_organization_color_palettes.test", "color_palettes.0.name"),
+				),
+			},
+		},
+	})
+}
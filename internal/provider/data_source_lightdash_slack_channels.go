@@ -0,0 +1,182 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &slackChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &slackChannelsDataSource{}
+)
+
+func NewSlackChannelsDataSource() datasource.DataSource {
+	return &slackChannelsDataSource{}
+}
+
+type slackChannelsDataSource struct {
+	client *api.Client
+}
+
+// slackChannelModel describes a Slack channel in the data source data model.
+type slackChannelModel struct {
+	ChannelID types.String `tfsdk:"channel_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+// slackChannelsDataSourceModel describes the data source data model.
+type slackChannelsDataSourceModel struct {
+	ID               types.String        `tfsdk:"id"`
+	OrganizationUUID types.String        `tfsdk:"organization_uuid"`
+	Search           types.String        `tfsdk:"search"`
+	IncludeArchived  types.Bool          `tfsdk:"include_archived"`
+	Channels         []slackChannelModel `tfsdk:"channels"`
+	ChannelIDsByName types.Map           `tfsdk:"channel_ids_by_name"`
+}
+
+func (d *slackChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_channels"
+}
+
+func (d *slackChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/data_sources/data_source_lightdash_slack_channels.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Lightdash Slack channels data source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier. It is computed as `organizations/<organization_uuid>/slack_channels`.",
+				Computed:            true,
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash organization.",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only return the channels whose name contains this term.",
+				Optional:            true,
+			},
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to include archived channels. Defaults to `false`.",
+				Optional:            true,
+			},
+			"channels": schema.ListNestedAttribute{
+				MarkdownDescription: "The channels visible to the Slack app, in the order returned by Lightdash.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"channel_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the channel.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the channel, such as `#general`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"channel_ids_by_name": schema.MapAttribute{
+				MarkdownDescription: "The channel IDs keyed by channel name without the leading `#`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *slackChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *slackChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state slackChannelsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationUUID(d.client, state.OrganizationUUID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSlackIntegrationService(d.client)
+	channels, err := service.ListSlackChannels(ctx, state.Search.ValueString(), !state.IncludeArchived.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to get Slack channels", err.Error())
+		return
+	}
+
+	channelModels, channelIDsByName := slackChannelModels(channels)
+	mapValue, diags := types.MapValueFrom(ctx, types.StringType, channelIDsByName)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("organizations/%s/slack_channels", state.OrganizationUUID.ValueString()))
+	state.Channels = channelModels
+	state.ChannelIDsByName = mapValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// slackChannelModels converts the channels and indexes their IDs by name. Lightdash prefixes channel names with `#`,
+// which is dropped from the keys so that channels can be looked up by their plain name.
+func slackChannelModels(channels []apiv1.SlackChannelV1) ([]slackChannelModel, map[string]string) {
+	channelModels := make([]slackChannelModel, 0, len(channels))
+	channelIDsByName := make(map[string]string, len(channels))
+	for _, channel := range channels {
+		channelModels = append(channelModels, slackChannelModel{
+			ChannelID: types.StringValue(channel.ID),
+			Name:      types.StringValue(channel.Name),
+		})
+		channelIDsByName[strings.TrimPrefix(channel.Name, "#")] = channel.ID
+	}
+	return channelModels, channelIDsByName
+}
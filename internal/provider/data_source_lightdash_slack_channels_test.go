@@ -0,0 +1,70 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestSlackChannelModels(t *testing.T) {
+	t.Parallel()
+
+	channels, channelIDsByName := slackChannelModels([]apiv1.SlackChannelV1{
+		{ID: "C0123", Name: "#data-alerts"},
+		{ID: "C0456", Name: "analytics"},
+	})
+	if len(channels) != 2 {
+		t.Fatalf("expected 2 channels, got %d", len(channels))
+	}
+	if channels[0].ChannelID.ValueString() != "C0123" || channels[0].Name.ValueString() != "#data-alerts" {
+		t.Errorf("unexpected first channel: %+v", channels[0])
+	}
+	if channelIDsByName["data-alerts"] != "C0123" || channelIDsByName["analytics"] != "C0456" {
+		t.Errorf("unexpected channel IDs by name: %v", channelIDsByName)
+	}
+}
+
+func TestAccSlackChannelsDataSource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for data_source_lightdash_slack_channels")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	config, err := ReadAccTestResource([]string{"data_sources", "lightdash_slack_channels", "data", "010_data.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get slackChannelsConfig: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lightdash_slack_channels.test", "id"),
+					resource.TestCheckResourceAttrSet("data.lightdash_slack_channels.test", "channels.#"),
+				),
+			},
+		},
+	})
+}
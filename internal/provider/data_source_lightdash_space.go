@@ -60,6 +60,7 @@ type spaceDetailedModel struct {
 	ProjectUUID      types.String        `tfsdk:"project_uuid"`
 	ParentSpaceUUID  types.String        `tfsdk:"parent_space_uuid"`
 	SpaceUUID        types.String        `tfsdk:"space_uuid"`
+	Path             types.String        `tfsdk:"path"`
 	SpaceName        types.String        `tfsdk:"name"`
 	IsPrivate        types.Bool          `tfsdk:"is_private"`
 	Access           []spaceAccessMember `tfsdk:"access"`
@@ -97,8 +98,13 @@ func (d *spaceDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Required:    true,
 			},
 			"space_uuid": schema.StringAttribute{
-				Description: "Space UUID of the Lightdash space.",
-				Required:    true,
+				Description: "Space UUID of the Lightdash space. Either `space_uuid` or `path` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Slash-separated path of the Lightdash space from the root of the project, such as `Marketing/Campaigns/2026`. A slash in a space name is escaped as `\\/`. Either `space_uuid` or `path` must be set.",
+				Optional:    true,
 			},
 			"parent_space_uuid": schema.StringAttribute{
 				Description: "Parent space UUID of the Lightdash space. This attribute is nullable and will be empty if the space has no parent.",
@@ -196,6 +202,26 @@ func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	projectUuid := state.ProjectUUID.ValueString()
 	spaceUuid := state.SpaceUUID.ValueString()
 
+	// Look up the space by path when no UUID is given
+	if state.SpaceUUID.IsNull() == state.Path.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Lightdash space lookup",
+			"Exactly one of space_uuid and path must be set.",
+		)
+		return
+	}
+	if !state.Path.IsNull() {
+		foundSpaceUuid, err := spaceController.FindSpaceByPath(ctx, projectUuid, state.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find Lightdash space",
+				fmt.Sprintf("Unable to find space with path %q in project %q: %s", state.Path.ValueString(), projectUuid, err.Error()),
+			)
+			return
+		}
+		spaceUuid = foundSpaceUuid
+	}
+
 	space, err := spaceController.GetSpace(ctx, projectUuid, spaceUuid)
 	if err != nil {
 		resp.Diagnostics.AddError(
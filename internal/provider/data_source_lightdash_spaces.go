@@ -16,21 +16,26 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
 
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ datasource.DataSource              = &spacesDataSource{}
-	_ datasource.DataSourceWithConfigure = &spacesDataSource{}
+	_ datasource.DataSource                   = &spacesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &spacesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &spacesDataSource{}
 )
 
 func NewSpacesDataSource() datasource.DataSource {
@@ -47,6 +52,8 @@ type spaceModel struct {
 	SpaceUUID       types.String `tfsdk:"space_uuid"`
 	SpaceName       types.String `tfsdk:"name"`
 	IsPrivate       types.Bool   `tfsdk:"is_private"`
+	Path            types.String `tfsdk:"path"`
+	Depth           types.Int64  `tfsdk:"depth"`
 }
 
 // projectDataSourceModel describes the data source data model.
@@ -54,7 +61,22 @@ type spacesDataSourceModel struct {
 	ID               types.String `tfsdk:"id"`
 	OrganizationUUID types.String `tfsdk:"organization_uuid"`
 	ProjectUUID      types.String `tfsdk:"project_uuid"`
+	NameRegex        types.String `tfsdk:"name_regex"`
+	ParentSpaceUUID  types.String `tfsdk:"parent_space_uuid"`
+	RootOnly         types.Bool   `tfsdk:"root_only"`
+	IsPrivate        types.Bool   `tfsdk:"is_private"`
+	IncludeTree      types.Bool   `tfsdk:"include_tree"`
 	Spaces           []spaceModel `tfsdk:"spaces"`
+	Tree             types.String `tfsdk:"tree"`
+}
+
+// spaceTreeNodeModel is a node of the JSON document in the 'tree' attribute.
+type spaceTreeNodeModel struct {
+	SpaceUUID string               `json:"space_uuid"`
+	Name      string               `json:"name"`
+	Path      string               `json:"path"`
+	IsPrivate bool                 `json:"is_private"`
+	Children  []spaceTreeNodeModel `json:"children"`
 }
 
 func (d *spacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -87,8 +109,32 @@ func (d *spacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				MarkdownDescription: "The UUID of the Lightdash project.",
 				Required:            true,
 			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the spaces whose name matches this regular expression, in the RE2 syntax.",
+				Optional:            true,
+			},
+			"parent_space_uuid": schema.StringAttribute{
+				MarkdownDescription: "Only list the direct child spaces of this space.",
+				Optional:            true,
+			},
+			"root_only": schema.BoolAttribute{
+				MarkdownDescription: "Only list the root spaces, which have no parent space. It cannot be combined with `parent_space_uuid`.",
+				Optional:            true,
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Only list the private (`true`) or the public (`false`) spaces.",
+				Optional:            true,
+			},
+			"include_tree": schema.BoolAttribute{
+				MarkdownDescription: "Whether to compute the `tree` attribute. Defaults to `false`.",
+				Optional:            true,
+			},
+			"tree": schema.StringAttribute{
+				MarkdownDescription: "The listed spaces as a JSON-encoded tree, to be read with `jsondecode`. It is an array of nodes with `space_uuid`, `name`, `path`, `is_private` and `children`. A space whose parent is not listed is at the top level. It is null unless `include_tree` is `true`.",
+				Computed:            true,
+			},
 			"spaces": schema.ListNestedAttribute{
-				MarkdownDescription: "A list of spaces within the specified project.",
+				MarkdownDescription: "A list of spaces within the specified project that match the filters.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -109,6 +155,14 @@ func (d *spacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 							MarkdownDescription: "Whether the space is private (`true`) or public (`false`).",
 							Computed:            true,
 						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The slash-separated names of the space and its parent spaces, from the root space down, such as `Marketing/Campaigns`. A slash in a name is escaped as `\\/`.",
+							Computed:            true,
+						},
+						"depth": schema.Int64Attribute{
+							MarkdownDescription: "The number of parent spaces above the space. Root spaces have a depth of `0`.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -134,6 +188,27 @@ func (d *spacesDataSource) Configure(ctx context.Context, req datasource.Configu
 	d.client = client
 }
 
+func (d *spacesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config spacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.NameRegex.IsNull() && !config.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		}
+	}
+	if config.RootOnly.ValueBool() && !config.ParentSpaceUUID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("root_only"),
+			"Conflicting filters",
+			"root_only cannot be true when parent_space_uuid is set, as root spaces have no parent space.",
+		)
+	}
+}
+
 func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state spacesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -151,6 +226,18 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	// Paths and depths are computed from all the spaces, before filtering
+	paths, depths := computeSpacePaths(spaces)
+
 	// Map response body to model
 	updatedSpaces := []spaceModel{}
 	for _, space := range spaces {
@@ -165,6 +252,11 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			SpaceUUID:       types.StringValue(space.SpaceUUID),
 			SpaceName:       types.StringValue(space.SpaceName),
 			IsPrivate:       types.BoolValue(space.TerraformIsPrivate()),
+			Path:            types.StringValue(paths[space.SpaceUUID]),
+			Depth:           types.Int64Value(depths[space.SpaceUUID]),
+		}
+		if !spaceMatchesFilters(spaceState, state, nameRegex) {
+			continue
 		}
 		updatedSpaces = append(updatedSpaces, spaceState)
 	}
@@ -174,6 +266,16 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	})
 	state.Spaces = updatedSpaces
 
+	state.Tree = types.StringNull()
+	if state.IncludeTree.ValueBool() {
+		tree, err := json.Marshal(buildSpaceTree(updatedSpaces))
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to encode the space tree", err.Error())
+			return
+		}
+		state.Tree = types.StringValue(string(tree))
+	}
+
 	// Set resource ID
 	state_id := fmt.Sprintf("organizations/%s/projects/%s/spaces",
 		state.OrganizationUUID.ValueString(), state.ProjectUUID.ValueString())
@@ -186,3 +288,97 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 }
+
+// computeSpacePaths returns the path and the depth of every space, keyed by space UUID.
+// A parent space that is not in the list, for example because it is not visible, ends the path.
+func computeSpacePaths(spaces []apiv1.ListSpacesInProjectV1Results) (map[string]string, map[string]int64) {
+	byUUID := map[string]apiv1.ListSpacesInProjectV1Results{}
+	for _, space := range spaces {
+		byUUID[space.SpaceUUID] = space
+	}
+
+	paths := map[string]string{}
+	depths := map[string]int64{}
+	for _, space := range spaces {
+		names := []string{space.SpaceName}
+		visited := map[string]bool{space.SpaceUUID: true}
+		current := space
+		for !models.IsEmptyStringPointer(current.ParentSpaceUUID) {
+			parent, exists := byUUID[*current.ParentSpaceUUID]
+			// Stop on a cycle rather than looping forever
+			if !exists || visited[parent.SpaceUUID] {
+				break
+			}
+			visited[parent.SpaceUUID] = true
+			names = append([]string{parent.SpaceName}, names...)
+			current = parent
+		}
+		paths[space.SpaceUUID] = models.FormatSpacePath(names)
+		depths[space.SpaceUUID] = int64(len(names) - 1)
+	}
+	return paths, depths
+}
+
+// spaceMatchesFilters checks a space against the filters of the data source.
+func spaceMatchesFilters(space spaceModel, filters spacesDataSourceModel, nameRegex *regexp.Regexp) bool {
+	if nameRegex != nil && !nameRegex.MatchString(space.SpaceName.ValueString()) {
+		return false
+	}
+	if !filters.ParentSpaceUUID.IsNull() && space.ParentSpaceUUID.ValueString() != filters.ParentSpaceUUID.ValueString() {
+		return false
+	}
+	if filters.RootOnly.ValueBool() && !space.ParentSpaceUUID.IsNull() {
+		return false
+	}
+	if !filters.IsPrivate.IsNull() && space.IsPrivate.ValueBool() != filters.IsPrivate.ValueBool() {
+		return false
+	}
+	return true
+}
+
+// buildSpaceTree nests the spaces under their parents. Spaces whose parent is not in the list
+// are at the top level. Siblings are sorted by name, then by space UUID.
+func buildSpaceTree(spaces []spaceModel) []spaceTreeNodeModel {
+	listed := map[string]bool{}
+	for _, space := range spaces {
+		listed[space.SpaceUUID.ValueString()] = true
+	}
+	children := map[string][]spaceModel{}
+	roots := []spaceModel{}
+	for _, space := range spaces {
+		parentUUID := space.ParentSpaceUUID.ValueString()
+		if space.ParentSpaceUUID.IsNull() || !listed[parentUUID] || parentUUID == space.SpaceUUID.ValueString() {
+			roots = append(roots, space)
+			continue
+		}
+		children[parentUUID] = append(children[parentUUID], space)
+	}
+
+	visited := map[string]bool{}
+	var build func(siblings []spaceModel) []spaceTreeNodeModel
+	build = func(siblings []spaceModel) []spaceTreeNodeModel {
+		sort.Slice(siblings, func(i, j int) bool {
+			if siblings[i].SpaceName.ValueString() != siblings[j].SpaceName.ValueString() {
+				return siblings[i].SpaceName.ValueString() < siblings[j].SpaceName.ValueString()
+			}
+			return siblings[i].SpaceUUID.ValueString() < siblings[j].SpaceUUID.ValueString()
+		})
+		nodes := []spaceTreeNodeModel{}
+		for _, space := range siblings {
+			spaceUUID := space.SpaceUUID.ValueString()
+			if visited[spaceUUID] {
+				continue
+			}
+			visited[spaceUUID] = true
+			nodes = append(nodes, spaceTreeNodeModel{
+				SpaceUUID: spaceUUID,
+				Name:      space.SpaceName.ValueString(),
+				Path:      space.Path.ValueString(),
+				IsPrivate: space.IsPrivate.ValueBool(),
+				Children:  build(children[spaceUUID]),
+			})
+		}
+		return nodes
+	}
+	return build(roots)
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestComputeSpacePaths(t *testing.T) {
+	t.Parallel()
+
+	root, child, hidden := "root", "child", "hidden"
+	spaces := []apiv1.ListSpacesInProjectV1Results{
+		{SpaceUUID: "root", SpaceName: "Marketing"},
+		{SpaceUUID: "child", SpaceName: "Campaigns", ParentSpaceUUID: &root},
+		{SpaceUUID: "grandchild", SpaceName: "2026/Q1", ParentSpaceUUID: &child},
+		{SpaceUUID: "orphan", SpaceName: "Orphan", ParentSpaceUUID: &hidden},
+	}
+
+	paths, depths := computeSpacePaths(spaces)
+	wantPaths := map[string]string{
+		"root":       "Marketing",
+		"child":      "Marketing/Campaigns",
+		"grandchild": `Marketing/Campaigns/2026\/Q1`,
+		"orphan":     "Orphan",
+	}
+	wantDepths := map[string]int64{"root": 0, "child": 1, "grandchild": 2, "orphan": 0}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Errorf("unexpected paths: %v", paths)
+	}
+	if !reflect.DeepEqual(depths, wantDepths) {
+		t.Errorf("unexpected depths: %v", depths)
+	}
+}
+
+func TestComputeSpacePathsCycle(t *testing.T) {
+	t.Parallel()
+
+	a, b := "a", "b"
+	paths, depths := computeSpacePaths([]apiv1.ListSpacesInProjectV1Results{
+		{SpaceUUID: "a", SpaceName: "A", ParentSpaceUUID: &b},
+		{SpaceUUID: "b", SpaceName: "B", ParentSpaceUUID: &a},
+	})
+	if paths["a"] != "B/A" || depths["a"] != 1 {
+		t.Errorf("unexpected path %q and depth %d", paths["a"], depths["a"])
+	}
+}
+
+func TestSpaceMatchesFilters(t *testing.T) {
+	t.Parallel()
+
+	root := spaceModel{
+		SpaceUUID:       types.StringValue("root"),
+		SpaceName:       types.StringValue("Marketing"),
+		ParentSpaceUUID: types.StringNull(),
+		IsPrivate:       types.BoolValue(false),
+	}
+	child := spaceModel{
+		SpaceUUID:       types.StringValue("child"),
+		SpaceName:       types.StringValue("Campaigns"),
+		ParentSpaceUUID: types.StringValue("root"),
+		IsPrivate:       types.BoolValue(true),
+	}
+	noFilters := spacesDataSourceModel{
+		ParentSpaceUUID: types.StringNull(),
+		RootOnly:        types.BoolNull(),
+		IsPrivate:       types.BoolNull(),
+	}
+
+	cases := []struct {
+		name      string
+		filters   func(f spacesDataSourceModel) spacesDataSourceModel
+		nameRegex *regexp.Regexp
+		wantRoot  bool
+		wantChild bool
+	}{
+		{name: "no filters", filters: func(f spacesDataSourceModel) spacesDataSourceModel { return f }, wantRoot: true, wantChild: true},
+		{name: "name regex", filters: func(f spacesDataSourceModel) spacesDataSourceModel { return f }, nameRegex: regexp.MustCompile("^Camp"), wantChild: true},
+		{
+			name: "parent space",
+			filters: func(f spacesDataSourceModel) spacesDataSourceModel {
+				f.ParentSpaceUUID = types.StringValue("root")
+				return f
+			},
+			wantChild: true,
+		},
+		{
+			name: "root only",
+			filters: func(f spacesDataSourceModel) spacesDataSourceModel {
+				f.RootOnly = types.BoolValue(true)
+				return f
+			},
+			wantRoot: true,
+		},
+		{
+			name: "root only false",
+			filters: func(f spacesDataSourceModel) spacesDataSourceModel {
+				f.RootOnly = types.BoolValue(false)
+				return f
+			},
+			wantRoot:  true,
+			wantChild: true,
+		},
+		{
+			name: "public",
+			filters: func(f spacesDataSourceModel) spacesDataSourceModel {
+				f.IsPrivate = types.BoolValue(false)
+				return f
+			},
+			wantRoot: true,
+		},
+		{
+			name: "private root",
+			filters: func(f spacesDataSourceModel) spacesDataSourceModel {
+				f.RootOnly = types.BoolValue(true)
+				f.IsPrivate = types.BoolValue(true)
+				return f
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			filters := tc.filters(noFilters)
+			if got := spaceMatchesFilters(root, filters, tc.nameRegex); got != tc.wantRoot {
+				t.Errorf("root: got %v, want %v", got, tc.wantRoot)
+			}
+			if got := spaceMatchesFilters(child, filters, tc.nameRegex); got != tc.wantChild {
+				t.Errorf("child: got %v, want %v", got, tc.wantChild)
+			}
+		})
+	}
+}
+
+func TestBuildSpaceTree(t *testing.T) {
+	t.Parallel()
+
+	space := func(uuid, name, parent string) spaceModel {
+		parentUUID := types.StringNull()
+		if parent != "" {
+			parentUUID = types.StringValue(parent)
+		}
+		return spaceModel{
+			SpaceUUID:       types.StringValue(uuid),
+			SpaceName:       types.StringValue(name),
+			ParentSpaceUUID: parentUUID,
+			IsPrivate:       types.BoolValue(false),
+			Path:            types.StringValue(name),
+		}
+	}
+
+	tree := buildSpaceTree([]spaceModel{
+		space("sales", "Sales", ""),
+		space("q1", "Q1", "marketing"),
+		space("marketing", "Marketing", ""),
+		space("orphan", "Orphan", "unlisted"),
+		space("campaigns", "Campaigns", "marketing"),
+	})
+
+	summarize := func(nodes []spaceTreeNodeModel) []string {
+		names := []string{}
+		for _, node := range nodes {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+	if got := summarize(tree); !reflect.DeepEqual(got, []string{"Marketing", "Orphan", "Sales"}) {
+		t.Fatalf("unexpected top-level spaces: %v", got)
+	}
+	if got := summarize(tree[0].Children); !reflect.DeepEqual(got, []string{"Campaigns", "Q1"}) {
+		t.Errorf("unexpected children of Marketing: %v", got)
+	}
+	if tree[2].Children == nil || len(tree[2].Children) != 0 {
+		t.Errorf("expected an empty list of children for Sales, got %v", tree[2].Children)
+	}
+}
+
+func TestAccSpacesDataSource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for data_source_lightdash_spaces")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	config, err := ReadAccTestResource([]string{"data_sources", "lightdash_spaces", "data", "010_data.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lightdash_spaces.test", "spaces.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lightdash_spaces.test", "spaces.*", map[string]string{
+						"name":  "Spaces Child (Acceptance Test: data_source_lightdash_spaces)",
+						"path":  "Spaces Root (Acceptance Test: data_source_lightdash_spaces)/Spaces Child (Acceptance Test: data_source_lightdash_spaces)",
+						"depth": "1",
+					}),
+					resource.TestCheckResourceAttrSet("data.lightdash_spaces.test", "tree"),
+					resource.TestCheckResourceAttr("data.lightdash_spaces.children", "spaces.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.lightdash_spaces.children", "spaces.0.space_uuid",
+						"lightdash_space.spaces__child", "space_uuid",
+					),
+					resource.TestCheckNoResourceAttr("data.lightdash_spaces.children", "tree"),
+				),
+			},
+		},
+	})
+}
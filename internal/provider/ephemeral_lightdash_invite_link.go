@@ -0,0 +1,167 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &inviteLinkEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &inviteLinkEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &inviteLinkEphemeralResource{}
+)
+
+func NewInviteLinkEphemeralResource() ephemeral.EphemeralResource {
+	return &inviteLinkEphemeralResource{}
+}
+
+type inviteLinkEphemeralResource struct {
+	client *api.Client
+}
+
+type inviteLinkEphemeralResourceModel struct {
+	Email            types.String `tfsdk:"email"`
+	Role             types.String `tfsdk:"role"`
+	ExpirationDays   types.Int64  `tfsdk:"expiration_days"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+	UserUUID         types.String `tfsdk:"user_uuid"`
+	InviteCode       types.String `tfsdk:"invite_code"`
+	InviteURL        types.String `tfsdk:"invite_url"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+}
+
+func (r *inviteLinkEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite_link"
+}
+
+func (r *inviteLinkEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/ephemeral_resources/ephemeral_lightdash_invite_link.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Creates a Lightdash invite link for an email without storing it in the state",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the invited user.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The organization role the user gets when accepting the invite, such as `member` or `viewer`.",
+				Required:            true,
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of days the invite link is valid. Defaults to `%d`.", defaultInviteExpirationDays),
+				Optional:            true,
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization the user is invited to.",
+				Computed:            true,
+			},
+			"user_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the invited user.",
+				Computed:            true,
+			},
+			"invite_code": schema.StringAttribute{
+				MarkdownDescription: "The code of the invite link.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"invite_url": schema.StringAttribute{
+				MarkdownDescription: "The URL the user opens to accept the invite.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the invite link expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *inviteLinkEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *inviteLinkEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config inviteLinkEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, err := range validateInviteLinkConfig(config.Email, config.Role, config.ExpirationDays) {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid invite link", err.Error())
+	}
+}
+
+func (r *inviteLinkEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data inviteLinkEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	invite, err := service.InviteOrganizationMember(
+		ctx,
+		data.Email.ValueString(),
+		models.OrganizationMemberRole(data.Role.ValueString()),
+		inviteExpiration(data.ExpirationDays),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating invite link", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created ephemeral invite link for %s", invite.Email))
+
+	data.OrganizationUUID = types.StringValue(invite.OrganizationUUID)
+	data.UserUUID = types.StringValue(invite.UserUUID)
+	data.InviteCode = types.StringValue(invite.InviteCode)
+	data.InviteURL = types.StringValue(invite.InviteURL)
+	data.ExpiresAt = types.StringValue(invite.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,186 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &personalAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &personalAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &personalAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &personalAccessTokenEphemeralResource{}
+)
+
+// The number of minutes an ephemeral token is valid by default.
+const defaultEphemeralTokenExpirationMinutes = 60
+
+// The private data key holding the UUID of the token to revoke at close.
+const personalAccessTokenPrivateKey = "token_uuid"
+
+func NewPersonalAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &personalAccessTokenEphemeralResource{}
+}
+
+type personalAccessTokenEphemeralResource struct {
+	client *api.Client
+}
+
+type personalAccessTokenEphemeralResourceModel struct {
+	Description       types.String `tfsdk:"description"`
+	ExpirationMinutes types.Int64  `tfsdk:"expiration_minutes"`
+	TokenUUID         types.String `tfsdk:"token_uuid"`
+	Token             types.String `tfsdk:"token"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+func (r *personalAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *personalAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/ephemeral_resources/ephemeral_lightdash_personal_access_token.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Mints a short-lived Lightdash personal access token for the duration of a Terraform run",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the token, shown in the Lightdash settings while the token exists.",
+				Required:            true,
+			},
+			"expiration_minutes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of minutes the token is valid, in case it is not revoked at the end of the run. Defaults to `%d`.", defaultEphemeralTokenExpirationMinutes),
+				Optional:            true,
+			},
+			"token_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the personal access token.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The value of the token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token expires.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *personalAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *personalAccessTokenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config personalAccessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExpirationMinutes.IsNull() && !config.ExpirationMinutes.IsUnknown() && config.ExpirationMinutes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("expiration_minutes"), "Invalid expiration", "expiration_minutes must be at least 1")
+	}
+}
+
+func (r *personalAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data personalAccessTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minutes := int64(defaultEphemeralTokenExpirationMinutes)
+	if !data.ExpirationMinutes.IsNull() {
+		minutes = data.ExpirationMinutes.ValueInt64()
+	}
+
+	service := services.NewPersonalAccessTokenService(r.client)
+	token, err := service.CreateToken(ctx, data.Description.ValueString(), time.Duration(minutes)*time.Minute)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating personal access token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created ephemeral personal access token %s", token.UUID))
+
+	tokenUUID, err := json.Marshal(token.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error storing personal access token UUID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, personalAccessTokenPrivateKey, tokenUUID)...)
+
+	data.TokenUUID = types.StringValue(token.UUID)
+	data.Token = types.StringValue(token.Token)
+	data.ExpiresAt = nonEmptyStringValue(token.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the token at the end of the run.
+func (r *personalAccessTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	value, diags := req.Private.GetKey(ctx, personalAccessTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || value == nil {
+		return
+	}
+
+	var tokenUUID string
+	if err := json.Unmarshal(value, &tokenUUID); err != nil {
+		resp.Diagnostics.AddError("Error reading personal access token UUID", err.Error())
+		return
+	}
+
+	service := services.NewPersonalAccessTokenService(r.client)
+	if err := service.DeleteToken(ctx, tokenUUID); err != nil {
+		resp.Diagnostics.AddError("Error revoking personal access token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Revoked ephemeral personal access token %s", tokenUUID))
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Custom function to sign a Lightdash embed JWT for a dashboard.
+//
+// provider::lightdash::embed_jwt(
+//   secret: string,
+//   dashboard_uuid: string,
+//   user_attributes: map(string),
+//   expires_at: string,
+// )
+//
+// Returns the signed JWT as a string.
+
+// Ensure EmbedJWTFunction satisfies the function.Function interface.
+var _ function.Function = &EmbedJWTFunction{}
+
+// EmbedJWTFunction defines the function implementation.
+type EmbedJWTFunction struct{}
+
+func NewEmbedJWTFunction() function.Function {
+	return &EmbedJWTFunction{}
+}
+
+// Metadata returns the function type name.
+func (f *EmbedJWTFunction) Metadata(
+	_ context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "embed_jwt"
+}
+
+// Definition defines the function schema including parameters and return type.
+func (f *EmbedJWTFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/functions/function_embed_jwt.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Definition = function.Definition{
+		Summary:             "Sign a Lightdash embed JWT for a dashboard.",
+		MarkdownDescription: markdownDescription,
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secret",
+				MarkdownDescription: "The embed secret of the project, such as the `secret` of `lightdash_embed_configuration`.",
+			},
+			function.StringParameter{
+				Name:                "dashboard_uuid",
+				MarkdownDescription: "The UUID of the dashboard to embed.",
+			},
+			function.MapParameter{
+				Name:                "user_attributes",
+				MarkdownDescription: "The user attributes of the viewer, used by row-level filters.",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "expires_at",
+				MarkdownDescription: "The RFC 3339 timestamp when the token expires, such as `timeadd(plantimestamp(), \"1h\")`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run the function to sign the token.
+func (f *EmbedJWTFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var (
+		secret         string
+		dashboardUUID  string
+		userAttributes map[string]string
+		expiresAt      string
+	)
+
+	// Read arguments by position
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &secret, &dashboardUUID, &userAttributes, &expiresAt))
+	if resp.Error != nil {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("expires_at must be an RFC 3339 timestamp: %s", err.Error()))
+		return
+	}
+	if secret == "" {
+		resp.Error = function.NewArgumentFuncError(0, "secret must not be empty")
+		return
+	}
+
+	token, err := signEmbedJWT(secret, dashboardUUID, userAttributes, expiry)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	// Set the function execution result.
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, token))
+}
+
+type embedJWTHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type embedJWTContent struct {
+	Type          string `json:"type"`
+	DashboardUUID string `json:"dashboardUuid"`
+}
+
+type embedJWTPayload struct {
+	Content        embedJWTContent   `json:"content"`
+	UserAttributes map[string]string `json:"userAttributes"`
+	Exp            int64             `json:"exp"`
+}
+
+// signEmbedJWT signs an HS256 JWT that embeds a dashboard. It only depends on its arguments,
+// so that the function returns the same token during plan and apply.
+func signEmbedJWT(secret string, dashboardUUID string, userAttributes map[string]string, expiresAt time.Time) (string, error) {
+	if userAttributes == nil {
+		userAttributes = map[string]string{}
+	}
+
+	header, err := json.Marshal(embedJWTHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+	payload, err := json.Marshal(embedJWTPayload{
+		Content:        embedJWTContent{Type: "dashboard", DashboardUUID: dashboardUUID},
+		UserAttributes: userAttributes,
+		Exp:            expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT payload: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
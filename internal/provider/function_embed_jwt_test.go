@@ -0,0 +1,136 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestEmbedJWTRun_simple(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for function_embed_jwt")
+	}
+
+	// Get the provider config
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	// Get the function config
+	functionConfig010, err := ReadAccTestResource([]string{"functions", "embed_jwt", "simple", "010_simple.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get functionConfig: %v", err)
+	}
+
+	want, err := signEmbedJWT("embed-secret", "dashboard-uuid", map[string]string{"country": "JP"}, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Failed to sign the expected token: %v", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + functionConfig010,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("embed_jwt", want),
+				),
+			},
+		},
+	})
+}
+
+func TestSignEmbedJWT(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	token, err := signEmbedJWT("embed-secret", "dashboard-uuid", map[string]string{"country": "JP"}, expiresAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d: %s", len(parts), token)
+	}
+
+	header, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatalf("failed to decode header: %v", err)
+	}
+	if string(header) != `{"alg":"HS256","typ":"JWT"}` {
+		t.Errorf("unexpected header: %s", header)
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	var payload embedJWTPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		t.Fatalf("failed to unmarshal payload: %v", err)
+	}
+	if payload.Content.Type != "dashboard" || payload.Content.DashboardUUID != "dashboard-uuid" {
+		t.Errorf("unexpected content: %+v", payload.Content)
+	}
+	if payload.UserAttributes["country"] != "JP" {
+		t.Errorf("unexpected user attributes: %v", payload.UserAttributes)
+	}
+	if payload.Exp != expiresAt.Unix() {
+		t.Errorf("got exp %d, want %d", payload.Exp, expiresAt.Unix())
+	}
+
+	mac := hmac.New(sha256.New, []byte("embed-secret"))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if parts[2] != base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) {
+		t.Errorf("invalid signature: %s", parts[2])
+	}
+
+	// The token only depends on the arguments.
+	again, err := signEmbedJWT("embed-secret", "dashboard-uuid", map[string]string{"country": "JP"}, expiresAt)
+	if err != nil || again != token {
+		t.Errorf("expected the same token, got %q, %v", again, err)
+	}
+}
+
+func TestSignEmbedJWT_noUserAttributes(t *testing.T) {
+	t.Parallel()
+
+	token, err := signEmbedJWT("embed-secret", "dashboard-uuid", nil, time.Unix(0, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	if err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if !strings.Contains(string(payloadJSON), `"userAttributes":{}`) {
+		t.Errorf("expected empty user attributes, got %s", payloadJSON)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -30,7 +31,10 @@ import (
 )
 
 // Ensure LightdashProvider satisfies various provider interfaces.
-var _ provider.Provider = &lightdashProvider{}
+var (
+	_ provider.Provider                       = &lightdashProvider{}
+	_ provider.ProviderWithEphemeralResources = &lightdashProvider{}
+)
 
 // lightdashProvider defines the provider implementation.
 type lightdashProvider struct {
@@ -61,7 +65,7 @@ func (p *lightdashProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Required:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Personal access token for Lightdash",
+				MarkdownDescription: "Personal access token or service account token for Lightdash",
 				Required:            true,
 				Sensitive:           true,
 			},
@@ -126,6 +130,7 @@ func (p *lightdashProvider) Configure(ctx context.Context, req provider.Configur
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *lightdashProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -133,6 +138,10 @@ func (p *lightdashProvider) Resources(ctx context.Context) []func() resource.Res
 		NewOrganizationRoleMemberResource,
 		NewProjectRoleMemberResource,
 		NewSpaceResource,
+		NewSpaceTreeResource,
+		NewSpacePathResource,
+		NewSpaceAccessResource,
+		NewSpaceGroupAccessResource,
 		NewGroupResource,
 		NewProjectRoleGroupResource,
 		NewProjectSchedulerSettingsResource,
@@ -140,6 +149,22 @@ func (p *lightdashProvider) Resources(ctx context.Context) []func() resource.Res
 		NewProjectAgentResource,
 		NewProjectAgentEvaluationsResource,
 		NewOAuthApplicationResource,
+		NewDashboardResource,
+		NewSQLChartResource,
+		NewContentSyncResource,
+		NewContentPromotionResource,
+		NewContentPlacementResource,
+		NewPinnedItemsResource,
+		NewOrganizationSettingsResource,
+		NewOrganizationColorPaletteResource,
+		NewAllowedEmailDomainsResource,
+		NewOrganizationMemberResource,
+		NewInviteLinkResource,
+		NewPersonalAccessTokenResource,
+		NewServiceAccountResource,
+		NewScimTokenResource,
+		NewEmbedConfigurationResource,
+		NewSlackIntegrationResource,
 	}
 }
 
@@ -165,12 +190,22 @@ func (p *lightdashProvider) DataSources(ctx context.Context) []func() datasource
 		NewOrganizationAgentsDataSource,
 		NewOAuthApplicationDataSource,
 		NewOAuthApplicationsDataSource,
+		NewOrganizationColorPalettesDataSource,
+		NewSlackChannelsDataSource,
+	}
+}
+
+func (p *lightdashProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewInviteLinkEphemeralResource,
+		NewPersonalAccessTokenEphemeralResource,
 	}
 }
 
 func (p *lightdashProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewNormalizeProjectMembersFunction,
+		NewEmbedJWTFunction,
 	}
 }
 
@@ -0,0 +1,381 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &allowedEmailDomainsResource{}
+	_ resource.ResourceWithConfigure      = &allowedEmailDomainsResource{}
+	_ resource.ResourceWithImportState    = &allowedEmailDomainsResource{}
+	_ resource.ResourceWithValidateConfig = &allowedEmailDomainsResource{}
+)
+
+func NewAllowedEmailDomainsResource() resource.Resource {
+	return &allowedEmailDomainsResource{}
+}
+
+type allowedEmailDomainsResource struct {
+	client *api.Client
+}
+
+type allowedEmailDomainsResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+	EmailDomains     types.Set    `tfsdk:"email_domains"`
+	Role             types.String `tfsdk:"role"`
+	Projects         types.Set    `tfsdk:"projects"`
+}
+
+type allowedEmailDomainProjectModel struct {
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	Role        types.String `tfsdk:"role"`
+}
+
+var allowedEmailDomainProjectAttrTypes = map[string]attr.Type{
+	"project_uuid": types.StringType,
+	"role":         types.StringType,
+}
+
+func (r *allowedEmailDomainsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowed_email_domains"
+}
+
+func (r *allowedEmailDomainsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_allowed_email_domains.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the email domains allowed to self-join a Lightdash organization",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/allowed_email_domains`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization. It must match the organization of the configured API token.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email_domains": schema.SetAttribute{
+				MarkdownDescription: "The lowercase email domains, such as `example.com`, whose users can join the organization without an invitation. Public email providers are not allowed.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The organization role of users joining through an allowed email domain. One of `member`, `viewer`, `interactive_viewer` or `editor`.",
+				Required:            true,
+			},
+			"projects": schema.SetNestedAttribute{
+				MarkdownDescription: "The projects users joining through an allowed email domain get access to. Only allowed when `role` is `member`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: allowedEmailDomainProjectAttrTypes}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the project.",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The project role. One of `viewer`, `interactive_viewer` or `editor`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *allowedEmailDomainsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *allowedEmailDomainsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config allowedEmailDomainsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.EmailDomains.IsNull() && !config.EmailDomains.IsUnknown() {
+		var domains []types.String
+		resp.Diagnostics.Append(config.EmailDomains.ElementsAs(ctx, &domains, false)...)
+		for _, domain := range domains {
+			if domain.IsUnknown() {
+				continue
+			}
+			if err := validateAllowedEmailDomain(domain.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("email_domains"), "Invalid email domain", err.Error())
+			}
+		}
+	}
+
+	if !config.Role.IsNull() && !config.Role.IsUnknown() {
+		role := models.OrganizationMemberRole(config.Role.ValueString())
+		if !role.IsValidForAllowedEmailDomains() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("role"),
+				"Invalid role",
+				fmt.Sprintf("role %q is invalid, must be one of `member`, `viewer`, `interactive_viewer` or `editor`", config.Role.ValueString()),
+			)
+		}
+	}
+
+	if config.Projects.IsNull() || config.Projects.IsUnknown() {
+		return
+	}
+	var projects []allowedEmailDomainProjectModel
+	resp.Diagnostics.Append(config.Projects.ElementsAs(ctx, &projects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(projects) > 0 && !config.Role.IsUnknown() && config.Role.ValueString() != models.ORGANIZATION_MEMBER_ROLE.String() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("projects"),
+			"Projects not allowed",
+			"projects can only be set when role is `member`; other roles already give access to every project",
+		)
+	}
+	for _, project := range projects {
+		if project.Role.IsUnknown() {
+			continue
+		}
+		if !models.ProjectMemberRole(project.Role.ValueString()).IsValidForAllowedEmailDomains() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("projects"),
+				"Invalid project role",
+				fmt.Sprintf("project role %q is invalid, must be one of `viewer`, `interactive_viewer` or `editor`", project.Role.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *allowedEmailDomainsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan allowedEmailDomainsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, plan.OrganizationUUID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateAllowedEmailDomains(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(getAllowedEmailDomainsResourceID(plan.OrganizationUUID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *allowedEmailDomainsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state allowedEmailDomainsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewAllowedEmailDomainsService(r.client)
+	allowed, err := service.GetAllowedEmailDomains(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading allowed email domains", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setAllowedEmailDomainsResourceFromAllowedEmailDomains(ctx, &state, *allowed)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *allowedEmailDomainsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan allowedEmailDomainsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.updateAllowedEmailDomains(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes all allowed email domains, so that users can only join the organization by invitation.
+func (r *allowedEmailDomainsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state allowedEmailDomainsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewAllowedEmailDomainsService(r.client)
+	if err := service.ClearAllowedEmailDomains(ctx); err != nil {
+		resp.Diagnostics.AddError("Error clearing allowed email domains", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Cleared allowed email domains of organization %s", state.OrganizationUUID.ValueString()))
+}
+
+func (r *allowedEmailDomainsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractAllowedEmailDomainsResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	organizationUUID := extracted[0]
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUUID)...)
+}
+
+func (r *allowedEmailDomainsResource) updateAllowedEmailDomains(ctx context.Context, plan *allowedEmailDomainsResourceModel, diags *diag.Diagnostics) {
+	var domains []string
+	diags.Append(plan.EmailDomains.ElementsAs(ctx, &domains, false)...)
+	var projectModels []allowedEmailDomainProjectModel
+	diags.Append(plan.Projects.ElementsAs(ctx, &projectModels, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	projects := make([]apiv1.AllowedEmailDomainProjectV1, 0, len(projectModels))
+	for _, project := range projectModels {
+		projects = append(projects, apiv1.AllowedEmailDomainProjectV1{
+			ProjectUUID: project.ProjectUUID.ValueString(),
+			Role:        models.ProjectMemberRole(project.Role.ValueString()),
+		})
+	}
+	sort.Strings(domains)
+
+	service := services.NewAllowedEmailDomainsService(r.client)
+	allowed, err := service.UpdateAllowedEmailDomains(ctx, apiv1.AllowedEmailDomainsV1{
+		EmailDomains: domains,
+		Role:         models.OrganizationMemberRole(plan.Role.ValueString()),
+		Projects:     projects,
+	})
+	if err != nil {
+		diags.AddError("Error updating allowed email domains", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Updated allowed email domains of organization %s", plan.OrganizationUUID.ValueString()))
+
+	diags.Append(setAllowedEmailDomainsResourceFromAllowedEmailDomains(ctx, plan, *allowed)...)
+}
+
+func validateAllowedEmailDomain(domain string) error {
+	if domain != strings.ToLower(strings.TrimSpace(domain)) {
+		return fmt.Errorf("email domain %q must be lowercase without surrounding spaces", domain)
+	}
+	if strings.Contains(domain, "@") || !strings.Contains(domain, ".") {
+		return fmt.Errorf("email domain %q must be a domain such as example.com", domain)
+	}
+	if models.IsPublicEmailDomain(domain) {
+		return fmt.Errorf("email domain %q belongs to a public email provider, which Lightdash does not allow", domain)
+	}
+	return nil
+}
+
+func setAllowedEmailDomainsResourceFromAllowedEmailDomains(ctx context.Context, model *allowedEmailDomainsResourceModel, allowed apiv1.AllowedEmailDomainsV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	domains := allowed.EmailDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	emailDomains, d := types.SetValueFrom(ctx, types.StringType, domains)
+	diags.Append(d...)
+	model.EmailDomains = emailDomains
+	model.Role = types.StringValue(allowed.Role.String())
+
+	projectModels := make([]allowedEmailDomainProjectModel, 0, len(allowed.Projects))
+	for _, project := range allowed.Projects {
+		projectModels = append(projectModels, allowedEmailDomainProjectModel{
+			ProjectUUID: types.StringValue(project.ProjectUUID),
+			Role:        types.StringValue(project.Role.String()),
+		})
+	}
+	projects, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: allowedEmailDomainProjectAttrTypes}, projectModels)
+	diags.Append(d...)
+	model.Projects = projects
+
+	return diags
+}
+
+func getAllowedEmailDomainsResourceID(organizationUUID string) string {
+	return fmt.Sprintf("organizations/%s/allowed_email_domains", organizationUUID)
+}
+
+func extractAllowedEmailDomainsResourceID(input string) ([]string, error) {
+	pattern := `^organizations/([^/]+)/allowed_email_domains$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0]}, nil
+}
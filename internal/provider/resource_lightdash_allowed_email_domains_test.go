@@ -0,0 +1,143 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestExtractAllowedEmailDomainsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractAllowedEmailDomainsResourceID("organizations/org-uuid/allowed_email_domains")
+	if err != nil {
+		t.Fatalf("extractAllowedEmailDomainsResourceID: %v", err)
+	}
+	if got[0] != "org-uuid" {
+		t.Errorf("organization UUID: got %q", got[0])
+	}
+
+	if _, err := extractAllowedEmailDomainsResourceID("organizations/org-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetAllowedEmailDomainsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getAllowedEmailDomainsResourceID("org-uuid")
+	want := "organizations/org-uuid/allowed_email_domains"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateAllowedEmailDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		domain  string
+		wantErr bool
+	}{
+		{domain: "example.com"},
+		{domain: "corp.example.co.jp"},
+		{domain: "Example.com", wantErr: true},
+		{domain: "user@example.com", wantErr: true},
+		{domain: "localhost", wantErr: true},
+		{domain: "gmail.com", wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := validateAllowedEmailDomain(test.domain); (err != nil) != test.wantErr {
+			t.Errorf("validateAllowedEmailDomain(%q) error = %v, wantErr %v", test.domain, err, test.wantErr)
+		}
+	}
+}
+
+func TestSetAllowedEmailDomainsResourceFromAllowedEmailDomains(t *testing.T) {
+	t.Parallel()
+
+	var model allowedEmailDomainsResourceModel
+	diags := setAllowedEmailDomainsResourceFromAllowedEmailDomains(context.Background(), &model, apiv1.AllowedEmailDomainsV1{
+		Role: models.ORGANIZATION_MEMBER_ROLE,
+		Projects: []apiv1.AllowedEmailDomainProjectV1{
+			{ProjectUUID: "project-uuid", Role: models.PROJECT_VIEWER_ROLE},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("setAllowedEmailDomainsResourceFromAllowedEmailDomains: %v", diags)
+	}
+	if model.EmailDomains.IsNull() || len(model.EmailDomains.Elements()) != 0 {
+		t.Errorf("expected an empty set of email domains, got %v", model.EmailDomains)
+	}
+	if model.Role.ValueString() != "member" {
+		t.Errorf("unexpected role: %q", model.Role.ValueString())
+	}
+	if len(model.Projects.Elements()) != 1 {
+		t.Errorf("expected 1 project, got %d", len(model.Projects.Elements()))
+	}
+}
+
+func TestAccAllowedEmailDomainsResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_allowed_email_domains")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_allowed_email_domains", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_allowed_email_domains", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_allowed_email_domains.test", "email_domains.#", "1"),
+					resource.TestCheckResourceAttr("lightdash_allowed_email_domains.test", "role", "viewer"),
+					resource.TestCheckResourceAttr("lightdash_allowed_email_domains.test", "projects.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_allowed_email_domains.test", "role", "member"),
+					resource.TestCheckResourceAttr("lightdash_allowed_email_domains.test", "projects.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "lightdash_allowed_email_domains.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,331 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &contentPlacementResource{}
+	_ resource.ResourceWithConfigure      = &contentPlacementResource{}
+	_ resource.ResourceWithValidateConfig = &contentPlacementResource{}
+)
+
+func NewContentPlacementResource() resource.Resource {
+	return &contentPlacementResource{}
+}
+
+// contentPlacementResource keeps charts and dashboards in a space without managing their definitions.
+type contentPlacementResource struct {
+	client       *api.Client
+	spaceService *services.SpaceService
+}
+
+type contentPlacementResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectUUID    types.String `tfsdk:"project_uuid"`
+	SpaceUUID      types.String `tfsdk:"space_uuid"`
+	ChartUUIDs     types.Set    `tfsdk:"chart_uuids"`
+	DashboardUUIDs types.Set    `tfsdk:"dashboard_uuids"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+}
+
+func (r *contentPlacementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_placement"
+}
+
+func (r *contentPlacementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_content_placement.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Keeps Lightdash charts and dashboards in a space",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/spaces/<space_uuid>/content_placement`.",
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space that holds the content. Changing it moves the content to the new space.",
+				Required:            true,
+			},
+			"chart_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the saved charts and SQL charts to keep in the space.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"dashboard_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the dashboards to keep in the space.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the last Terraform update applied to the placement.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *contentPlacementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.spaceService = services.NewSpaceService(client)
+}
+
+func (r *contentPlacementResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contentPlacementResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ChartUUIDs.IsUnknown() || config.DashboardUUIDs.IsUnknown() {
+		return
+	}
+	if len(config.ChartUUIDs.Elements()) == 0 && len(config.DashboardUUIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("chart_uuids"),
+			"Missing content",
+			"At least one of chart_uuids and dashboard_uuids must contain a UUID.",
+		)
+	}
+}
+
+func (r *contentPlacementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contentPlacementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.placeContent(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(getContentPlacementResourceID(plan.ProjectUUID.ValueString(), plan.SpaceUUID.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contentPlacementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contentPlacementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Content that was moved out of the space is dropped from the state, so that the next plan moves it back
+	state.ChartUUIDs = r.readPlacedContent(ctx, state, models.CONTENT_TYPE_CHART, state.ChartUUIDs, &resp.Diagnostics)
+	state.DashboardUUIDs = r.readPlacedContent(ctx, state, models.CONTENT_TYPE_DASHBOARD, state.DashboardUUIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *contentPlacementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contentPlacementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.placeContent(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(getContentPlacementResourceID(plan.ProjectUUID.ValueString(), plan.SpaceUUID.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contentPlacementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state contentPlacementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The content is not owned by the resource, so it stays where it is
+	tflog.Info(ctx, fmt.Sprintf("Stopped managing the placement of content in space %s", state.SpaceUUID.ValueString()))
+}
+
+// placeContent moves the planned charts and dashboards that are not in the space yet.
+// Content that does not exist is skipped with a warning.
+func (r *contentPlacementResource) placeContent(ctx context.Context, plan contentPlacementResourceModel, diags *diag.Diagnostics) {
+	projectUUID := plan.ProjectUUID.ValueString()
+	spaceUUID := plan.SpaceUUID.ValueString()
+	contents := []struct {
+		contentType models.ContentType
+		uuids       types.Set
+	}{
+		{contentType: models.CONTENT_TYPE_CHART, uuids: plan.ChartUUIDs},
+		{contentType: models.CONTENT_TYPE_DASHBOARD, uuids: plan.DashboardUUIDs},
+	}
+	for _, content := range contents {
+		contentType := content.contentType
+		desired := []string{}
+		diags.Append(content.uuids.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() || len(desired) == 0 {
+			continue
+		}
+
+		locations, err := r.spaceService.ListContentSpaces(ctx, projectUUID, contentType)
+		if err != nil {
+			diags.AddError("Error placing content", err.Error())
+			return
+		}
+		misplaced, missing := misplacedContent(desired, locations, spaceUUID)
+		for _, contentUUID := range missing {
+			diags.AddWarning(
+				"Content not found",
+				fmt.Sprintf("The %s %s does not exist in project %s and was skipped. Remove it from the configuration.", contentType, contentUUID, projectUUID),
+			)
+		}
+		for _, contentUUID := range misplaced {
+			var err error
+			if locations[contentUUID].SQLChart {
+				err = r.spaceService.MoveSQLChart(ctx, projectUUID, contentUUID, spaceUUID)
+			} else {
+				err = r.spaceService.MoveContent(ctx, projectUUID, contentType, contentUUID, spaceUUID)
+			}
+			if err != nil {
+				diags.AddError(
+					"Error placing content",
+					fmt.Sprintf("Could not move %s %s to space %s: %s", contentType, contentUUID, spaceUUID, err.Error()),
+				)
+				continue
+			}
+			tflog.Info(ctx, fmt.Sprintf("Moved %s %s from space %s to space %s", contentType, contentUUID, locations[contentUUID].SpaceUUID, spaceUUID))
+		}
+	}
+}
+
+// readPlacedContent returns the content of the state that is still in the space.
+// Content that no longer exists is kept, as there is nothing to move back.
+func (r *contentPlacementResource) readPlacedContent(
+	ctx context.Context,
+	state contentPlacementResourceModel,
+	contentType models.ContentType,
+	uuids types.Set,
+	diags *diag.Diagnostics,
+) types.Set {
+	desired := []string{}
+	diags.Append(uuids.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() || len(desired) == 0 {
+		return uuids
+	}
+
+	locations, err := r.spaceService.ListContentSpaces(ctx, state.ProjectUUID.ValueString(), contentType)
+	if err != nil {
+		diags.AddError("Error reading content placement", err.Error())
+		return uuids
+	}
+	misplaced, missing := misplacedContent(desired, locations, state.SpaceUUID.ValueString())
+	for _, contentUUID := range misplaced {
+		tflog.Warn(ctx, fmt.Sprintf("The %s %s was moved to space %s, it will be moved back to space %s", contentType, contentUUID, locations[contentUUID].SpaceUUID, state.SpaceUUID.ValueString()))
+	}
+	for _, contentUUID := range missing {
+		diags.AddWarning(
+			"Content not found",
+			fmt.Sprintf("The %s %s no longer exists in project %s. Remove it from the configuration.", contentType, contentUUID, state.ProjectUUID.ValueString()),
+		)
+	}
+
+	placed := removeStrings(desired, misplaced)
+	placedSet, setDiags := types.SetValueFrom(ctx, types.StringType, placed)
+	diags.Append(setDiags...)
+	return placedSet
+}
+
+// misplacedContent splits the desired content that is not in the space into the content
+// that lives in another space and the content that does not exist. Both are sorted.
+func misplacedContent(desired []string, locations map[string]models.ContentLocation, spaceUUID string) (misplaced []string, missing []string) {
+	for _, contentUUID := range desired {
+		location, exists := locations[contentUUID]
+		switch {
+		case !exists:
+			missing = append(missing, contentUUID)
+		case location.SpaceUUID != spaceUUID:
+			misplaced = append(misplaced, contentUUID)
+		}
+	}
+	sort.Strings(misplaced)
+	sort.Strings(missing)
+	return misplaced, missing
+}
+
+// removeStrings returns the values that are not in the excluded values.
+func removeStrings(values []string, excluded []string) []string {
+	excludedSet := map[string]bool{}
+	for _, value := range excluded {
+		excludedSet[value] = true
+	}
+	kept := []string{}
+	for _, value := range values {
+		if !excludedSet[value] {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+func getContentPlacementResourceID(projectUUID, spaceUUID string) string {
+	return fmt.Sprintf("projects/%s/spaces/%s/content_placement", projectUUID, spaceUUID)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestContentPlacementResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getContentPlacementResourceID("project-1", "space-1")
+	if id != "projects/project-1/spaces/space-1/content_placement" {
+		t.Fatalf("unexpected resource ID: %s", id)
+	}
+}
+
+func TestMisplacedContent(t *testing.T) {
+	t.Parallel()
+
+	locations := map[string]models.ContentLocation{
+		"placed":    {SpaceUUID: "target"},
+		"moved-b":   {SpaceUUID: "other", SQLChart: true},
+		"moved-a":   {SpaceUUID: "root"},
+		"elsewhere": {SpaceUUID: "other"},
+	}
+	misplaced, missing := misplacedContent([]string{"placed", "moved-b", "deleted", "moved-a"}, locations, "target")
+	if !reflect.DeepEqual(misplaced, []string{"moved-a", "moved-b"}) {
+		t.Errorf("unexpected misplaced content: %v", misplaced)
+	}
+	if !reflect.DeepEqual(missing, []string{"deleted"}) {
+		t.Errorf("unexpected missing content: %v", missing)
+	}
+
+	misplaced, missing = misplacedContent([]string{"placed"}, locations, "target")
+	if len(misplaced) != 0 || len(missing) != 0 {
+		t.Errorf("expected nothing to move, got %v and %v", misplaced, missing)
+	}
+}
+
+func TestRemoveStrings(t *testing.T) {
+	t.Parallel()
+
+	got := removeStrings([]string{"a", "b", "c"}, []string{"b", "d"})
+	if !reflect.DeepEqual(got, []string{"a", "c"}) {
+		t.Errorf("unexpected result: %v", got)
+	}
+	if got := removeStrings(nil, []string{"a"}); len(got) != 0 {
+		t.Errorf("expected an empty result, got %v", got)
+	}
+}
+
+func TestAccContentPlacementResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_content_placement")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_content_placement", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	changeSpaceConfig, err := ReadAccTestResource([]string{"resources", "lightdash_content_placement", "lifecycle", "020_change_space.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_content_placement.test", "id"),
+					resource.TestCheckResourceAttr("lightdash_content_placement.test", "dashboard_uuids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"lightdash_content_placement.test", "space_uuid",
+						"lightdash_space.content_placement__target", "space_uuid",
+					),
+				),
+			},
+			{
+				// Refreshing the dashboard shows that it lives in the target space now
+				Config:   providerConfig + createConfig,
+				PlanOnly: true,
+			},
+			{
+				Config: providerConfig + changeSpaceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_content_placement.test", "dashboard_uuids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"lightdash_content_placement.test", "space_uuid",
+						"lightdash_space.content_placement__source", "space_uuid",
+					),
+				),
+			},
+		},
+	})
+}
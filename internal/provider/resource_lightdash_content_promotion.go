@@ -0,0 +1,318 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &contentPromotionResource{}
+	_ resource.ResourceWithConfigure      = &contentPromotionResource{}
+	_ resource.ResourceWithModifyPlan     = &contentPromotionResource{}
+	_ resource.ResourceWithValidateConfig = &contentPromotionResource{}
+)
+
+func NewContentPromotionResource() resource.Resource {
+	return &contentPromotionResource{}
+}
+
+type contentPromotionResource struct {
+	client *api.Client
+}
+
+type contentPromotionResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ProjectUUID         types.String `tfsdk:"project_uuid"`
+	ContentType         types.String `tfsdk:"content_type"`
+	ContentUUID         types.String `tfsdk:"content_uuid"`
+	Triggers            types.Map    `tfsdk:"triggers"`
+	UpstreamProjectUUID types.String `tfsdk:"upstream_project_uuid"`
+	PromotedContentUUID types.String `tfsdk:"promoted_content_uuid"`
+	Changes             types.List   `tfsdk:"changes"`
+}
+
+type contentPromotionChangeModel struct {
+	ContentType types.String `tfsdk:"content_type"`
+	Action      types.String `tfsdk:"action"`
+	UUID        types.String `tfsdk:"uuid"`
+	Name        types.String `tfsdk:"name"`
+}
+
+var contentPromotionChangeAttrTypes = map[string]attr.Type{
+	"content_type": types.StringType,
+	"action":       types.StringType,
+	"uuid":         types.StringType,
+	"name":         types.StringType,
+}
+
+func (r *contentPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_promotion"
+}
+
+func (r *contentPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_content_promotion.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Promotes a Lightdash chart or dashboard to the upstream project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/promotions/<content_type>/<content_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project containing the content. The project must have an upstream project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				MarkdownDescription: "The type of content to promote. One of `chart` or `dashboard`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the chart or dashboard to promote.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that promote the content again when they change.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"upstream_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the upstream project the content was promoted to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"promoted_content_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the promoted chart or dashboard in the upstream project.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "The spaces, charts and dashboards created or updated in the upstream project by the promotion.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the changed content. One of `space`, `chart` or `dashboard`.",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "The change applied to the content, such as `create`, `update` or `no changes`.",
+							Computed:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the content in the upstream project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the content.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *contentPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *contentPromotionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contentPromotionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ContentType.IsNull() && !config.ContentType.IsUnknown() && !models.ContentType(config.ContentType.ValueString()).IsValid() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content_type"),
+			"Invalid content type",
+			fmt.Sprintf("content_type %q is invalid, must be `chart` or `dashboard`", config.ContentType.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan fails the plan when the project has no upstream project to promote to.
+func (r *contentPromotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan contentPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectUUID.IsUnknown() {
+		return
+	}
+
+	service := services.NewContentPromotionService(r.client)
+	if _, err := service.GetUpstreamProjectUUID(ctx, plan.ProjectUUID.ValueString()); err != nil {
+		if errors.Is(err, services.ErrNoUpstreamProject) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_uuid"),
+				"Project has no upstream project",
+				fmt.Sprintf("Project %s has no upstream project to promote content to. Set one with the lightdash_project_upstream resource.", plan.ProjectUUID.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading upstream project", err.Error())
+	}
+}
+
+func (r *contentPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contentPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewContentPromotionService(r.client)
+	result, err := service.Promote(
+		ctx,
+		plan.ProjectUUID.ValueString(),
+		models.ContentType(plan.ContentType.ValueString()),
+		plan.ContentUUID.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error promoting content", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Promoted %s %s to project %s", plan.ContentType.ValueString(), plan.ContentUUID.ValueString(), result.UpstreamProjectUUID))
+
+	plan.ID = types.StringValue(getContentPromotionResourceID(plan.ProjectUUID.ValueString(), plan.ContentType.ValueString(), plan.ContentUUID.ValueString()))
+	plan.UpstreamProjectUUID = types.StringValue(result.UpstreamProjectUUID)
+	plan.PromotedContentUUID = types.StringValue(result.PromotedUUID)
+	changes, diags := contentPromotionChangesValue(ctx, result.Changes)
+	resp.Diagnostics.Append(diags...)
+	plan.Changes = changes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is. A promotion is a one-off action, so there is nothing to refresh.
+func (r *contentPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contentPromotionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes because every configurable attribute requires replacement.
+func (r *contentPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contentPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state. The promoted content is left in the upstream project.
+func (r *contentPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state contentPromotionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing promotion %s from state; the promoted content is kept", state.ID.ValueString()))
+}
+
+func contentPromotionChangesValue(ctx context.Context, changes models.PromotionChanges) (types.List, diag.Diagnostics) {
+	changeModels := []contentPromotionChangeModel{}
+	for _, group := range []struct {
+		contentType string
+		changes     []models.PromotionChange
+	}{
+		{"space", changes.Spaces},
+		{"chart", changes.Charts},
+		{"dashboard", changes.Dashboards},
+	} {
+		for _, change := range group.changes {
+			changeModels = append(changeModels, contentPromotionChangeModel{
+				ContentType: types.StringValue(group.contentType),
+				Action:      types.StringValue(change.Action),
+				UUID:        types.StringValue(change.Data.UUID),
+				Name:        types.StringValue(change.Data.Name),
+			})
+		}
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: contentPromotionChangeAttrTypes}, changeModels)
+}
+
+func getContentPromotionResourceID(projectUUID string, contentType string, contentUUID string) string {
+	return fmt.Sprintf("projects/%s/promotions/%s/%s", projectUUID, contentType, contentUUID)
+}
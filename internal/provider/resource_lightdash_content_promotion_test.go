@@ -0,0 +1,71 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestGetContentPromotionResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getContentPromotionResourceID("project-uuid", "dashboard", "dashboard-uuid")
+	want := "projects/project-uuid/promotions/dashboard/dashboard-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestContentPromotionChangesValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	changes := models.PromotionChanges{
+		Spaces: []models.PromotionChange{
+			{Action: "create", Data: models.PromotionChangeData{UUID: "space-uuid", Name: "Executive"}},
+		},
+		Dashboards: []models.PromotionChange{
+			{Action: "update", Data: models.PromotionChangeData{UUID: "dashboard-uuid", Name: "Executive overview"}},
+		},
+		Charts: []models.PromotionChange{
+			{Action: "no changes", Data: models.PromotionChangeData{UUID: "chart-uuid", Name: "Weekly orders"}},
+		},
+	}
+
+	value, diags := contentPromotionChangesValue(ctx, changes)
+	if diags.HasError() {
+		t.Fatalf("contentPromotionChangesValue: %v", diags)
+	}
+	var got []contentPromotionChangeModel
+	if diags := value.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("ElementsAs: %v", diags)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 changes, got %d", len(got))
+	}
+	// Spaces come first, then charts, then dashboards.
+	wantTypes := []string{"space", "chart", "dashboard"}
+	for i, change := range got {
+		if change.ContentType.ValueString() != wantTypes[i] {
+			t.Errorf("change %d: got content type %q, want %q", i, change.ContentType.ValueString(), wantTypes[i])
+		}
+	}
+	if got[2].UUID.ValueString() != "dashboard-uuid" || got[2].Action.ValueString() != "update" {
+		t.Errorf("unexpected dashboard change: %+v", got[2])
+	}
+}
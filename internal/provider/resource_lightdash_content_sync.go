@@ -0,0 +1,586 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &contentSyncResource{}
+	_ resource.ResourceWithConfigure      = &contentSyncResource{}
+	_ resource.ResourceWithModifyPlan     = &contentSyncResource{}
+	_ resource.ResourceWithValidateConfig = &contentSyncResource{}
+)
+
+func NewContentSyncResource() resource.Resource {
+	return &contentSyncResource{}
+}
+
+type contentSyncResource struct {
+	client          *api.Client
+	spaceController *controllers.SpaceController
+}
+
+type contentSyncResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectUUID   types.String `tfsdk:"project_uuid"`
+	Directory     types.String `tfsdk:"directory"`
+	Documents     types.List   `tfsdk:"documents"`
+	DeleteMissing types.Bool   `tfsdk:"delete_missing"`
+	ContentHashes types.Map    `tfsdk:"content_hashes"`
+}
+
+func (r *contentSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_sync"
+}
+
+func (r *contentSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_content_sync.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Uploads charts and dashboards in the Lightdash CLI download format",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/content_syncs/<uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project to upload the content to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "The directory written by `lightdash download`, containing `charts` and `dashboards` subdirectories of YAML files. Conflicts with `documents`.",
+				Optional:            true,
+			},
+			"documents": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Chart and dashboard YAML documents in the `lightdash download` format. Documents with `tiles` are dashboards; the others are charts. Conflicts with `directory`.",
+				Optional:            true,
+			},
+			"delete_missing": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, charts and dashboards in the project that are not part of the synced content are deleted. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"content_hashes": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A digest of each synced item, keyed by `chart/<slug>` or `dashboard/<slug>`. Plan shows an entry change for each item that differs from the content in Lightdash.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *contentSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.spaceController = controllers.NewSpaceController(client)
+}
+
+func (r *contentSyncResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contentSyncResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Directory.IsUnknown() || config.Documents.IsUnknown() {
+		return
+	}
+	if config.Directory.IsNull() == config.Documents.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("directory"),
+			"Invalid content sync configuration",
+			"Exactly one of directory or documents must be set.",
+		)
+	}
+}
+
+// ModifyPlan computes the digests of the local content and compares them with the live content,
+// so that plan lists each item that will be uploaded or deleted even when the state was not refreshed.
+func (r *contentSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan contentSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Directory.IsUnknown() || plan.Documents.IsUnknown() {
+		plan.ContentHashes = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	contents, diags := loadContentSyncContents(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hashes, diags := contentHashesValue(contents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ContentHashes = hashes
+
+	if r.client != nil && !plan.ProjectUUID.IsUnknown() && !plan.DeleteMissing.IsUnknown() {
+		changes, err := r.planContentChanges(ctx, plan.ProjectUUID.ValueString(), contents, plan.DeleteMissing.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not compare content with Lightdash",
+				fmt.Sprintf("Could not list the content of project %s: %s", plan.ProjectUUID.ValueString(), err.Error()),
+			)
+		} else if len(changes) > 0 {
+			resp.Diagnostics.AddWarning(
+				"Content will be changed",
+				fmt.Sprintf("Applying the content sync changes the live content:\n  - %s", strings.Join(changes, "\n  - ")),
+			)
+			// Without a refresh the state may still match the local content; force an update.
+			if !req.State.Raw.IsNull() {
+				var state contentSyncResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if !resp.Diagnostics.HasError() && state.ContentHashes.Equal(plan.ContentHashes) {
+					plan.ContentHashes = types.MapUnknown(types.StringType)
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// planContentChanges describes the uploads and deletions a sync makes against the live content,
+// and the spaces Lightdash creates for them.
+func (r *contentSyncResource) planContentChanges(ctx context.Context, projectUUID string, contents []models.ContentAsCode, deleteMissing bool) ([]string, error) {
+	service := services.NewContentAsCodeService(r.client)
+	live, err := service.ListContent(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	uploads, deletions, err := diffContents(contents, live, deleteMissing)
+	if err != nil {
+		return nil, err
+	}
+	spaceSlugs, err := r.spaceController.ResolveSpaceSlugs(ctx, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []string{}
+	for _, slug := range missingContentSpaces(uploads, spaceSlugs) {
+		changes = append(changes, fmt.Sprintf("create space %q", slug))
+	}
+	for _, content := range uploads {
+		changes = append(changes, fmt.Sprintf("upload %s", content.Key()))
+	}
+	for _, content := range deletions {
+		changes = append(changes, fmt.Sprintf("delete %s", content.Key()))
+	}
+	return changes, nil
+}
+
+func (r *contentSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan contentSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(getContentSyncResourceID(plan.ProjectUUID.ValueString(), uuid.NewString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *contentSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state contentSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewContentAsCodeService(r.client)
+	live, err := service.ListContent(ctx, state.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading content", err.Error())
+		return
+	}
+	liveHashes, err := hashContents(live)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading content", err.Error())
+		return
+	}
+
+	// Track the synced items. With delete_missing, every item in the project is tracked
+	// so that content added in the UI shows up as a removal in plan.
+	stateHashes := map[string]string{}
+	if !state.ContentHashes.IsNull() && !state.ContentHashes.IsUnknown() {
+		resp.Diagnostics.Append(state.ContentHashes.ElementsAs(ctx, &stateHashes, false)...)
+	}
+	refreshed := map[string]attr.Value{}
+	for key, hash := range liveHashes {
+		if _, ok := stateHashes[key]; ok || state.DeleteMissing.ValueBool() {
+			refreshed[key] = types.StringValue(hash)
+		}
+	}
+	hashes, diags := types.MapValue(types.StringType, refreshed)
+	resp.Diagnostics.Append(diags...)
+	state.ContentHashes = hashes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *contentSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state contentSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state. The uploaded content is left in Lightdash.
+func (r *contentSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state contentSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing content sync %s from state; the content is kept in Lightdash", state.ID.ValueString()))
+}
+
+// sync uploads the local items that differ from Lightdash and deletes missing items when requested.
+func (r *contentSyncResource) sync(ctx context.Context, plan *contentSyncResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	contents, loadDiags := loadContentSyncContents(ctx, *plan)
+	diags.Append(loadDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	service := services.NewContentAsCodeService(r.client)
+	projectUUID := plan.ProjectUUID.ValueString()
+	live, err := service.ListContent(ctx, projectUUID)
+	if err != nil {
+		diags.AddError("Error syncing content", err.Error())
+		return diags
+	}
+	uploads, deletions, err := diffContents(contents, live, plan.DeleteMissing.ValueBool())
+	if err != nil {
+		diags.AddError("Error syncing content", err.Error())
+		return diags
+	}
+
+	if len(uploads) > 0 {
+		spaceSlugs, err := r.spaceController.ResolveSpaceSlugs(ctx, projectUUID)
+		if err != nil {
+			diags.AddError("Error resolving spaces", err.Error())
+			return diags
+		}
+		for _, slug := range missingContentSpaces(uploads, spaceSlugs) {
+			tflog.Info(ctx, fmt.Sprintf("Space %q does not exist; Lightdash creates it on upload", slug))
+		}
+	}
+	for _, content := range uploads {
+		tflog.Info(ctx, fmt.Sprintf("Uploading %s", content.Key()))
+		if _, err := service.UpsertContent(ctx, projectUUID, content); err != nil {
+			diags.AddError("Error uploading content", err.Error())
+			return diags
+		}
+	}
+
+	if len(deletions) > 0 {
+		contentUUIDs, err := service.ListContentUUIDs(ctx, projectUUID)
+		if err != nil {
+			diags.AddError("Error deleting content", err.Error())
+			return diags
+		}
+		for _, content := range deletions {
+			for _, contentUUID := range contentUUIDs[content.Key()] {
+				tflog.Info(ctx, fmt.Sprintf("Deleting %s (%s)", content.Key(), contentUUID))
+				if err := service.DeleteContent(ctx, content.Type, contentUUID); err != nil {
+					diags.AddError("Error deleting content", err.Error())
+					return diags
+				}
+			}
+		}
+	}
+
+	hashes, hashDiags := contentHashesValue(contents)
+	diags.Append(hashDiags...)
+	plan.ContentHashes = hashes
+	return diags
+}
+
+// diffContents returns the local items that differ from the live content, in upload order,
+// and with deleteMissing the live items that are not local, in deletion order.
+// Charts are uploaded before the dashboards that reference them by slug, and deleted after them.
+func diffContents(local []models.ContentAsCode, live []models.ContentAsCode, deleteMissing bool) ([]models.ContentAsCode, []models.ContentAsCode, error) {
+	localHashes, err := hashContents(local)
+	if err != nil {
+		return nil, nil, err
+	}
+	liveHashes, err := hashContents(live)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uploads := []models.ContentAsCode{}
+	for _, content := range sortContentsForUpload(local) {
+		if liveHashes[content.Key()] != localHashes[content.Key()] {
+			uploads = append(uploads, content)
+		}
+	}
+	deletions := []models.ContentAsCode{}
+	if deleteMissing {
+		for _, content := range sortContentsForDeletion(live) {
+			if _, ok := localHashes[content.Key()]; !ok {
+				deletions = append(deletions, content)
+			}
+		}
+	}
+	return uploads, deletions, nil
+}
+
+// missingContentSpaces returns the sorted space slugs referenced by the contents that are not in spaceSlugs.
+func missingContentSpaces(contents []models.ContentAsCode, spaceSlugs map[string]string) []string {
+	missing := map[string]bool{}
+	for _, content := range contents {
+		slug, ok := content.Document["spaceSlug"].(string)
+		if !ok || slug == "" {
+			continue
+		}
+		if _, found := spaceSlugs[slug]; !found {
+			missing[slug] = true
+		}
+	}
+	slugs := make([]string, 0, len(missing))
+	for slug := range missing {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	return slugs
+}
+
+// loadContentSyncContents reads the content from the configured directory or documents.
+func loadContentSyncContents(ctx context.Context, model contentSyncResourceModel) ([]models.ContentAsCode, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !model.Directory.IsNull() {
+		contents, err := loadContentAsCodeDirectory(model.Directory.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("directory"), "Error reading content directory", err.Error())
+		}
+		return contents, diags
+	}
+
+	var documents []string
+	diags.Append(model.Documents.ElementsAs(ctx, &documents, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	contents, err := loadContentAsCodeDocuments(documents)
+	if err != nil {
+		diags.AddAttributeError(path.Root("documents"), "Error reading content documents", err.Error())
+	}
+	return contents, diags
+}
+
+// loadContentAsCodeDirectory reads the YAML files in the `charts` and `dashboards` subdirectories.
+func loadContentAsCodeDirectory(directory string) ([]models.ContentAsCode, error) {
+	subdirectories := map[string]models.ContentType{
+		"charts":     models.CONTENT_TYPE_CHART,
+		"dashboards": models.CONTENT_TYPE_DASHBOARD,
+	}
+
+	var contents []models.ContentAsCode
+	found := false
+	for _, name := range []string{"charts", "dashboards"} {
+		entries, err := os.ReadDir(filepath.Join(directory, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", filepath.Join(directory, name), err)
+		}
+		found = true
+
+		for _, entry := range entries {
+			extension := filepath.Ext(entry.Name())
+			if entry.IsDir() || (extension != ".yml" && extension != ".yaml") {
+				continue
+			}
+			filename := filepath.Join(directory, name, entry.Name())
+			data, err := os.ReadFile(filepath.Clean(filename))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", filename, err)
+			}
+			document, err := models.ParseContentAsCodeYAML(data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			content, err := models.NewContentAsCode(subdirectories[name], document)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			contents = append(contents, *content)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("directory %s contains neither a charts nor a dashboards subdirectory", directory)
+	}
+
+	return contents, checkDuplicateContents(contents)
+}
+
+func loadContentAsCodeDocuments(documents []string) ([]models.ContentAsCode, error) {
+	contents := make([]models.ContentAsCode, 0, len(documents))
+	for i, data := range documents {
+		document, err := models.ParseContentAsCodeYAML([]byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		content, err := models.NewContentAsCode(models.DetectContentType(document), document)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		contents = append(contents, *content)
+	}
+	return contents, checkDuplicateContents(contents)
+}
+
+func checkDuplicateContents(contents []models.ContentAsCode) error {
+	seen := map[string]bool{}
+	for _, content := range contents {
+		if seen[content.Key()] {
+			return fmt.Errorf("%s is defined more than once", content.Key())
+		}
+		seen[content.Key()] = true
+	}
+	return nil
+}
+
+func hashContents(contents []models.ContentAsCode) (map[string]string, error) {
+	hashes := make(map[string]string, len(contents))
+	for _, content := range contents {
+		hash, err := content.Hash()
+		if err != nil {
+			return nil, err
+		}
+		hashes[content.Key()] = hash
+	}
+	return hashes, nil
+}
+
+func contentHashesValue(contents []models.ContentAsCode) (types.Map, diag.Diagnostics) {
+	hashes, err := hashContents(contents)
+	if err != nil {
+		return types.MapNull(types.StringType), diag.Diagnostics{
+			diag.NewErrorDiagnostic("Error hashing content", err.Error()),
+		}
+	}
+	elements := make(map[string]attr.Value, len(hashes))
+	for key, hash := range hashes {
+		elements[key] = types.StringValue(hash)
+	}
+	return types.MapValue(types.StringType, elements)
+}
+
+func sortContentsForUpload(contents []models.ContentAsCode) []models.ContentAsCode {
+	sorted := make([]models.ContentAsCode, len(contents))
+	copy(sorted, contents)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		// "chart" sorts before "dashboard".
+		return strings.Compare(sorted[i].Key(), sorted[j].Key()) < 0
+	})
+	return sorted
+}
+
+func sortContentsForDeletion(contents []models.ContentAsCode) []models.ContentAsCode {
+	sorted := sortContentsForUpload(contents)
+	for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+		sorted[i], sorted[j] = sorted[j], sorted[i]
+	}
+	return sorted
+}
+
+func getContentSyncResourceID(projectUUID string, syncUUID string) string {
+	return fmt.Sprintf("projects/%s/content_syncs/%s", projectUUID, syncUUID)
+}
@@ -0,0 +1,196 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestLoadContentAsCodeDirectory(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	files := map[string]string{
+		"charts/weekly-orders.yml":         "slug: weekly-orders\nname: Weekly orders\nspaceSlug: operations\n",
+		"charts/README.md":                 "not a chart",
+		"dashboards/operations.yaml":       "slug: operations\nname: Operations\ntiles: []\n",
+		"dashboards/nested/ignored.yml":    "slug: ignored\n",
+		"dashboards/operations-daily.yaml": "slug: operations-daily\nname: Operations daily\ntiles: []\n",
+	}
+	for name, content := range files {
+		filename := filepath.Join(directory, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contents, err := loadContentAsCodeDirectory(directory)
+	if err != nil {
+		t.Fatalf("loadContentAsCodeDirectory: %v", err)
+	}
+	keys := map[string]bool{}
+	for _, content := range contents {
+		keys[content.Key()] = true
+	}
+	for _, key := range []string{"chart/weekly-orders", "dashboard/operations", "dashboard/operations-daily"} {
+		if !keys[key] {
+			t.Errorf("expected %s to be loaded, got %v", key, keys)
+		}
+	}
+	if len(contents) != 3 {
+		t.Errorf("expected 3 items, got %d", len(contents))
+	}
+
+	if _, err := loadContentAsCodeDirectory(t.TempDir()); err == nil {
+		t.Error("expected error for a directory without charts or dashboards")
+	}
+}
+
+func TestLoadContentAsCodeDocuments(t *testing.T) {
+	t.Parallel()
+
+	contents, err := loadContentAsCodeDocuments([]string{
+		"slug: weekly-orders\nname: Weekly orders\n",
+		"slug: operations\nname: Operations\ntiles: []\n",
+	})
+	if err != nil {
+		t.Fatalf("loadContentAsCodeDocuments: %v", err)
+	}
+	if contents[0].Type != models.CONTENT_TYPE_CHART || contents[1].Type != models.CONTENT_TYPE_DASHBOARD {
+		t.Errorf("unexpected content types: %s, %s", contents[0].Type, contents[1].Type)
+	}
+
+	if _, err := loadContentAsCodeDocuments([]string{"slug: a\n", "slug: a\nname: A\n"}); err == nil {
+		t.Error("expected error for duplicated slugs")
+	}
+	if _, err := loadContentAsCodeDocuments([]string{"name: no slug\n"}); err == nil {
+		t.Error("expected error for a document without slug")
+	}
+}
+
+func TestSortContentsForUpload(t *testing.T) {
+	t.Parallel()
+
+	contents := []models.ContentAsCode{
+		{Type: models.CONTENT_TYPE_DASHBOARD, Slug: "operations"},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "weekly-orders"},
+	}
+
+	upload := sortContentsForUpload(contents)
+	if upload[0].Type != models.CONTENT_TYPE_CHART {
+		t.Errorf("expected charts to be uploaded first, got %s", upload[0].Key())
+	}
+	deletion := sortContentsForDeletion(contents)
+	if deletion[0].Type != models.CONTENT_TYPE_DASHBOARD {
+		t.Errorf("expected dashboards to be deleted first, got %s", deletion[0].Key())
+	}
+}
+
+func TestDiffContents(t *testing.T) {
+	t.Parallel()
+
+	local := []models.ContentAsCode{
+		{Type: models.CONTENT_TYPE_DASHBOARD, Slug: "operations", Document: map[string]any{"slug": "operations", "tiles": []any{}}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "weekly-orders", Document: map[string]any{"slug": "weekly-orders", "name": "Weekly orders"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "unchanged", Document: map[string]any{"slug": "unchanged"}},
+	}
+	live := []models.ContentAsCode{
+		{Type: models.CONTENT_TYPE_CHART, Slug: "weekly-orders", Document: map[string]any{"slug": "weekly-orders", "name": "Orders"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "unchanged", Document: map[string]any{"slug": "unchanged", "updatedAt": "2024-01-01"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "legacy", Document: map[string]any{"slug": "legacy"}},
+		{Type: models.CONTENT_TYPE_DASHBOARD, Slug: "legacy", Document: map[string]any{"slug": "legacy", "tiles": []any{}}},
+	}
+
+	keys := func(contents []models.ContentAsCode) []string {
+		result := []string{}
+		for _, content := range contents {
+			result = append(result, content.Key())
+		}
+		return result
+	}
+
+	uploads, deletions, err := diffContents(local, live, true)
+	if err != nil {
+		t.Fatalf("diffContents: %v", err)
+	}
+	if got, want := keys(uploads), []string{"chart/weekly-orders", "dashboard/operations"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("uploads = %v, want %v", got, want)
+	}
+	if got, want := keys(deletions), []string{"dashboard/legacy", "chart/legacy"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("deletions = %v, want %v", got, want)
+	}
+
+	_, deletions, err = diffContents(local, live, false)
+	if err != nil || len(deletions) != 0 {
+		t.Errorf("expected no deletions without delete_missing, got %v, %v", keys(deletions), err)
+	}
+}
+
+func TestMissingContentSpaces(t *testing.T) {
+	t.Parallel()
+
+	contents := []models.ContentAsCode{
+		{Type: models.CONTENT_TYPE_CHART, Slug: "a", Document: map[string]any{"spaceSlug": "sales/weekly"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "b", Document: map[string]any{"spaceSlug": "operations"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "c", Document: map[string]any{"spaceSlug": "marketing"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "d", Document: map[string]any{"spaceSlug": "operations"}},
+		{Type: models.CONTENT_TYPE_CHART, Slug: "e", Document: map[string]any{}},
+	}
+	spaceSlugs := map[string]string{"sales/weekly": "weekly-uuid"}
+
+	got := missingContentSpaces(contents, spaceSlugs)
+	if want := []string{"marketing", "operations"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("missingContentSpaces() = %v, want %v", got, want)
+	}
+}
+
+func TestAccContentSyncResource_documents(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_content_sync")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_content_sync", "documents", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_content_sync.test", "id"),
+					resource.TestCheckResourceAttrSet("lightdash_content_sync.test", "content_hashes.dashboard/acceptance-test-content-sync"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,1004 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithConfigure      = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
+)
+
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+type dashboardResource struct {
+	client *api.Client
+}
+
+type dashboardResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectUUID      types.String `tfsdk:"project_uuid"`
+	SpaceUUID        types.String `tfsdk:"space_uuid"`
+	DashboardUUID    types.String `tfsdk:"dashboard_uuid"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Tabs             types.List   `tfsdk:"tabs"`
+	TabUUIDs         types.Map    `tfsdk:"tab_uuids"`
+	Tiles            types.Set    `tfsdk:"tiles"`
+	Filters          types.List   `tfsdk:"filters"`
+	MetricFilters    types.List   `tfsdk:"metric_filters"`
+	TableCalcFilters types.List   `tfsdk:"table_calculation_filters"`
+	DeleteProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type dashboardTileModel struct {
+	Type           types.String `tfsdk:"type"`
+	X              types.Int64  `tfsdk:"x"`
+	Y              types.Int64  `tfsdk:"y"`
+	Width          types.Int64  `tfsdk:"width"`
+	Height         types.Int64  `tfsdk:"height"`
+	Tab            types.String `tfsdk:"tab"`
+	Title          types.String `tfsdk:"title"`
+	HideTitle      types.Bool   `tfsdk:"hide_title"`
+	SavedChartUUID types.String `tfsdk:"saved_chart_uuid"`
+	SavedSQLUUID   types.String `tfsdk:"saved_sql_uuid"`
+	Content        types.String `tfsdk:"content"`
+	URL            types.String `tfsdk:"url"`
+}
+
+type dashboardFilterModel struct {
+	FieldID   types.String `tfsdk:"field_id"`
+	TableName types.String `tfsdk:"table_name"`
+	Operator  types.String `tfsdk:"operator"`
+	Values    types.List   `tfsdk:"values"`
+	Label     types.String `tfsdk:"label"`
+	Disabled  types.Bool   `tfsdk:"disabled"`
+}
+
+var dashboardTileAttrTypes = map[string]attr.Type{
+	"type":             types.StringType,
+	"x":                types.Int64Type,
+	"y":                types.Int64Type,
+	"width":            types.Int64Type,
+	"height":           types.Int64Type,
+	"tab":              types.StringType,
+	"title":            types.StringType,
+	"hide_title":       types.BoolType,
+	"saved_chart_uuid": types.StringType,
+	"saved_sql_uuid":   types.StringType,
+	"content":          types.StringType,
+	"url":              types.StringType,
+}
+
+var dashboardFilterAttrTypes = map[string]attr.Type{
+	"field_id":   types.StringType,
+	"table_name": types.StringType,
+	"operator":   types.StringType,
+	"values":     types.ListType{ElemType: types.StringType},
+	"label":      types.StringType,
+	"disabled":   types.BoolType,
+}
+
+func (r *dashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (r *dashboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_dashboard.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash dashboard",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/dashboards/<dashboard_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project the dashboard belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space containing the dashboard. Changing it moves the dashboard to another space.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"dashboard_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the dashboard assigned by Lightdash.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the dashboard.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the dashboard.",
+				Optional:            true,
+			},
+			"tabs": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The ordered list of tab names. Tab names must be unique within the dashboard. Leave empty for a dashboard without tabs.",
+				Optional:            true,
+			},
+			"tab_uuids": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map from tab name to the tab UUID in Lightdash.",
+				Computed:            true,
+			},
+			"tiles": schema.SetNestedAttribute{
+				MarkdownDescription: "The tiles of the dashboard. Tiles are compared as a set, so their order in the configuration does not matter.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The tile type. One of `saved_chart`, `sql_chart`, `markdown` or `loom`.",
+							Required:            true,
+						},
+						"x": schema.Int64Attribute{
+							MarkdownDescription: "The horizontal position of the tile on the dashboard grid.",
+							Required:            true,
+						},
+						"y": schema.Int64Attribute{
+							MarkdownDescription: "The vertical position of the tile on the dashboard grid.",
+							Required:            true,
+						},
+						"width": schema.Int64Attribute{
+							MarkdownDescription: "The width of the tile in grid columns. The dashboard grid is 36 columns wide.",
+							Required:            true,
+						},
+						"height": schema.Int64Attribute{
+							MarkdownDescription: "The height of the tile in grid rows.",
+							Required:            true,
+						},
+						"tab": schema.StringAttribute{
+							MarkdownDescription: "The name of the tab the tile is placed on. Must be one of `tabs`.",
+							Optional:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "The title of the tile. For chart tiles, the chart name is shown when unset.",
+							Optional:            true,
+						},
+						"hide_title": schema.BoolAttribute{
+							MarkdownDescription: "Whether to hide the tile title.",
+							Optional:            true,
+						},
+						"saved_chart_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the saved chart. Required for `saved_chart` tiles.",
+							Optional:            true,
+						},
+						"saved_sql_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the SQL chart. Required for `sql_chart` tiles.",
+							Optional:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The markdown content. Required for `markdown` tiles.",
+							Optional:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The Loom video URL. Required for `loom` tiles.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				MarkdownDescription: "The dashboard-level dimension filters.",
+				Optional:            true,
+				NestedObject:        dashboardFilterNestedObject("dimension", "`orders_status`"),
+			},
+			"metric_filters": schema.ListNestedAttribute{
+				MarkdownDescription: "The dashboard-level metric filters.",
+				Optional:            true,
+				NestedObject:        dashboardFilterNestedObject("metric", "`orders_total_revenue`"),
+			},
+			"table_calculation_filters": schema.ListNestedAttribute{
+				MarkdownDescription: "The dashboard-level table calculation filters.",
+				Optional:            true,
+				NestedObject:        dashboardFilterNestedObject("table calculation", "`revenue_share`"),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, prevents the destruction of the dashboard resource by Terraform.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// dashboardFilterNestedObject is the schema of a dashboard filter on a field of the given kind.
+func dashboardFilterNestedObject(fieldKind string, fieldExample string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The ID of the %s field to filter on, such as %s.", fieldKind, fieldExample),
+				Required:            true,
+			},
+			"table_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the table the field belongs to.",
+				Required:            true,
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "The filter operator, such as `equals`, `notEquals`, `isNull` or `inThePast`.",
+				Required:            true,
+			},
+			"values": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The filter values.",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label shown for the filter on the dashboard.",
+				Optional:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the filter is disabled by default.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *dashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dashboardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tabs []types.String
+	if !config.Tabs.IsNull() && !config.Tabs.IsUnknown() {
+		resp.Diagnostics.Append(config.Tabs.ElementsAs(ctx, &tabs, false)...)
+	}
+	var tiles []dashboardTileModel
+	if !config.Tiles.IsNull() && !config.Tiles.IsUnknown() {
+		resp.Diagnostics.Append(config.Tiles.ElementsAs(ctx, &tiles, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, configErr := range validateDashboardConfig(tabs, tiles) {
+		attributePath := path.Root(configErr.attribute).AtListIndex(configErr.index)
+		if configErr.attribute == "tiles" {
+			attributePath = path.Root("tiles").AtSetValue(config.Tiles.Elements()[configErr.index])
+		}
+		resp.Diagnostics.AddAttributeError(attributePath, "Invalid dashboard configuration", configErr.err.Error())
+	}
+}
+
+// dashboardConfigError is an invalid tab or tile, identified by its attribute and its index in it.
+type dashboardConfigError struct {
+	attribute string
+	index     int
+	err       error
+}
+
+// validateDashboardConfig checks the tab names and the properties required by each tile type.
+// Unknown values are skipped because they are only known at apply time.
+func validateDashboardConfig(tabs []types.String, tiles []dashboardTileModel) []dashboardConfigError {
+	var errs []dashboardConfigError
+	tabError := func(index int, err error) {
+		errs = append(errs, dashboardConfigError{attribute: "tabs", index: index, err: err})
+	}
+	tileError := func(index int, err error) {
+		errs = append(errs, dashboardConfigError{attribute: "tiles", index: index, err: err})
+	}
+
+	tabNames := map[string]bool{}
+	tabsKnown := true
+	for i, tab := range tabs {
+		if tab.IsUnknown() {
+			tabsKnown = false
+			continue
+		}
+		if tabNames[tab.ValueString()] {
+			tabError(i, fmt.Errorf("tab name %q is duplicated", tab.ValueString()))
+		}
+		tabNames[tab.ValueString()] = true
+	}
+
+	for i, tile := range tiles {
+		if tile.Type.IsUnknown() {
+			continue
+		}
+		tileType := models.DashboardTileType(tile.Type.ValueString())
+		if !tileType.IsValid() {
+			tileError(i, fmt.Errorf("tile type %q is invalid, must be one of %v", tile.Type.ValueString(), models.DashboardTileTypes()))
+			continue
+		}
+
+		var required types.String
+		var requiredName string
+		switch tileType {
+		case models.DASHBOARD_SAVED_CHART_TILE:
+			required, requiredName = tile.SavedChartUUID, "saved_chart_uuid"
+		case models.DASHBOARD_SQL_CHART_TILE:
+			required, requiredName = tile.SavedSQLUUID, "saved_sql_uuid"
+		case models.DASHBOARD_MARKDOWN_TILE:
+			required, requiredName = tile.Content, "content"
+		case models.DASHBOARD_LOOM_TILE:
+			required, requiredName = tile.URL, "url"
+		}
+		if required.IsNull() {
+			tileError(i, fmt.Errorf("%s tiles require %s", tileType, requiredName))
+		}
+
+		if !tile.Width.IsUnknown() && !tile.Width.IsNull() && tile.Width.ValueInt64() <= 0 {
+			tileError(i, fmt.Errorf("tile width must be positive, got %d", tile.Width.ValueInt64()))
+		}
+		if !tile.Height.IsUnknown() && !tile.Height.IsNull() && tile.Height.ValueInt64() <= 0 {
+			tileError(i, fmt.Errorf("tile height must be positive, got %d", tile.Height.ValueInt64()))
+		}
+
+		if tabsKnown && !tile.Tab.IsNull() && !tile.Tab.IsUnknown() && !tabNames[tile.Tab.ValueString()] {
+			tileError(i, fmt.Errorf("tile tab %q is not one of the dashboard tabs", tile.Tab.ValueString()))
+		}
+	}
+
+	return errs
+}
+
+func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tabs, tiles, filters, diags := buildDashboardLayout(ctx, plan, map[string]string{}, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewDashboardService(r.client)
+	created, err := service.CreateDashboard(ctx, plan.ProjectUUID.ValueString(), apiv1.CreateDashboardV1Request{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		SpaceUUID:   plan.SpaceUUID.ValueString(),
+		Tiles:       tiles,
+		Tabs:        tabs,
+		Filters:     filters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating dashboard", err.Error())
+		return
+	}
+
+	plan.DashboardUUID = types.StringValue(created.UUID)
+	plan.ID = types.StringValue(getDashboardResourceID(plan.ProjectUUID.ValueString(), created.UUID))
+	resp.Diagnostics.Append(setDashboardResourceFromDashboard(ctx, &plan, *created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewDashboardService(r.client)
+	dashboard, err := service.GetDashboard(ctx, state.DashboardUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrDashboardNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Dashboard %s not found during Read, removing from state", state.DashboardUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading dashboard", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(setDashboardResourceFromDashboard(ctx, &state, *dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the UUIDs of existing tabs, tiles and filters so that links to them stay valid.
+	existingTabUUIDs := map[string]string{}
+	if !state.TabUUIDs.IsNull() && !state.TabUUIDs.IsUnknown() {
+		resp.Diagnostics.Append(state.TabUUIDs.ElementsAs(ctx, &existingTabUUIDs, false)...)
+	}
+	service := services.NewDashboardService(r.client)
+	live, err := service.GetDashboard(ctx, state.DashboardUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading dashboard", err.Error())
+		return
+	}
+	tabs, tiles, filters, diags := buildDashboardLayout(ctx, plan, existingTabUUIDs, live)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := service.UpdateDashboard(ctx, state.DashboardUUID.ValueString(), apiv1.UpdateDashboardV1Request{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		SpaceUUID:   plan.SpaceUUID.ValueString(),
+		Tiles:       tiles,
+		Tabs:        tabs,
+		Filters:     filters,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating dashboard", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.DashboardUUID = state.DashboardUUID
+	resp.Diagnostics.Append(setDashboardResourceFromDashboard(ctx, &plan, *updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting dashboard %s", state.DashboardUUID.ValueString()))
+
+	if state.DeleteProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			"Cannot delete dashboard because deletion_protection is set to true. Set deletion_protection to false to allow deletion.",
+		)
+		return
+	}
+
+	service := services.NewDashboardService(r.client)
+	if err := service.DeleteDashboard(ctx, state.DashboardUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting dashboard", err.Error())
+		return
+	}
+}
+
+func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Accept both the resource identifier and a bare dashboard UUID.
+	dashboardUUID := req.ID
+	if strings.Contains(req.ID, "/") {
+		extracted, err := extractDashboardResourceID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+			return
+		}
+		dashboardUUID = extracted[1]
+	}
+
+	service := services.NewDashboardService(r.client)
+	dashboard, err := service.GetDashboard(ctx, dashboardUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading dashboard for import", err.Error())
+		return
+	}
+
+	state := dashboardResourceModel{
+		ID:               types.StringValue(getDashboardResourceID(dashboard.ProjectUUID, dashboard.UUID)),
+		ProjectUUID:      types.StringValue(dashboard.ProjectUUID),
+		DashboardUUID:    types.StringValue(dashboard.UUID),
+		Tabs:             types.ListNull(types.StringType),
+		TabUUIDs:         types.MapNull(types.StringType),
+		Tiles:            types.SetNull(types.ObjectType{AttrTypes: dashboardTileAttrTypes}),
+		Filters:          types.ListNull(types.ObjectType{AttrTypes: dashboardFilterAttrTypes}),
+		MetricFilters:    types.ListNull(types.ObjectType{AttrTypes: dashboardFilterAttrTypes}),
+		TableCalcFilters: types.ListNull(types.ObjectType{AttrTypes: dashboardFilterAttrTypes}),
+		DeleteProtection: types.BoolValue(true),
+	}
+	resp.Diagnostics.Append(setDashboardResourceFromDashboard(ctx, &state, *dashboard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// buildDashboardLayout converts the planned tabs, tiles and filters into the API representation.
+// Tabs found in existingTabUUIDs keep their UUID; new tabs get a generated one.
+// Tiles and filters matching those of the live dashboard keep their UUID, so that Lightdash updates
+// them in place; new tiles get a UUID from Lightdash and new filters a generated one.
+func buildDashboardLayout(ctx context.Context, plan dashboardResourceModel, existingTabUUIDs map[string]string, live *apiv1.DashboardV1) ([]apiv1.DashboardTabV1, []apiv1.DashboardTileV1, apiv1.DashboardFiltersV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var tabNames []string
+	if !plan.Tabs.IsNull() {
+		diags.Append(plan.Tabs.ElementsAs(ctx, &tabNames, false)...)
+	}
+	var tileModels []dashboardTileModel
+	if !plan.Tiles.IsNull() {
+		diags.Append(plan.Tiles.ElementsAs(ctx, &tileModels, false)...)
+	}
+	if diags.HasError() {
+		return nil, nil, apiv1.DashboardFiltersV1{}, diags
+	}
+
+	tabs := make([]apiv1.DashboardTabV1, 0, len(tabNames))
+	tabUUIDs := map[string]string{}
+	for i, name := range tabNames {
+		tabUUID, ok := existingTabUUIDs[name]
+		if !ok {
+			tabUUID = uuid.NewString()
+		}
+		tabUUIDs[name] = tabUUID
+		tabs = append(tabs, apiv1.DashboardTabV1{UUID: tabUUID, Name: name, Order: int64(i)})
+	}
+
+	tiles := make([]apiv1.DashboardTileV1, 0, len(tileModels))
+	for _, tile := range tileModels {
+		apiTile := apiv1.DashboardTileV1{
+			Type: tile.Type.ValueString(),
+			X:    tile.X.ValueInt64(),
+			Y:    tile.Y.ValueInt64(),
+			W:    tile.Width.ValueInt64(),
+			H:    tile.Height.ValueInt64(),
+			Properties: apiv1.DashboardTilePropertiesV1{
+				Title:          tile.Title.ValueStringPointer(),
+				HideTitle:      tile.HideTitle.ValueBoolPointer(),
+				SavedChartUUID: tile.SavedChartUUID.ValueStringPointer(),
+				SavedSQLUUID:   tile.SavedSQLUUID.ValueStringPointer(),
+				Content:        tile.Content.ValueStringPointer(),
+				URL:            tile.URL.ValueStringPointer(),
+			},
+		}
+		// Markdown and loom tiles always carry a title in Lightdash.
+		if apiTile.Properties.Title == nil && (apiTile.Type == models.DASHBOARD_MARKDOWN_TILE.String() || apiTile.Type == models.DASHBOARD_LOOM_TILE.String()) {
+			emptyTitle := ""
+			apiTile.Properties.Title = &emptyTitle
+		}
+		if !tile.Tab.IsNull() {
+			tabUUID, ok := tabUUIDs[tile.Tab.ValueString()]
+			if !ok {
+				diags.AddError("Invalid dashboard tile", fmt.Sprintf("tile tab %q is not one of the dashboard tabs", tile.Tab.ValueString()))
+				continue
+			}
+			apiTile.TabUUID = &tabUUID
+		}
+		tiles = append(tiles, apiTile)
+	}
+
+	var liveFilters apiv1.DashboardFiltersV1
+	if live != nil {
+		reuseDashboardTileUUIDs(tiles, live.Tiles)
+		liveFilters = live.Filters
+	}
+
+	dimensions, dimensionDiags := buildDashboardFilterRules(ctx, plan.Filters, liveFilters.Dimensions)
+	diags.Append(dimensionDiags...)
+	metrics, metricDiags := buildDashboardFilterRules(ctx, plan.MetricFilters, liveFilters.Metrics)
+	diags.Append(metricDiags...)
+	tableCalculations, tableCalculationDiags := buildDashboardFilterRules(ctx, plan.TableCalcFilters, liveFilters.TableCalculations)
+	diags.Append(tableCalculationDiags...)
+	filters := apiv1.DashboardFiltersV1{
+		Dimensions:        dimensions,
+		Metrics:           metrics,
+		TableCalculations: tableCalculations,
+	}
+
+	return tabs, tiles, filters, diags
+}
+
+// buildDashboardFilterRules converts the planned filters of one kind into API filter rules.
+// A filter on the same field as a live filter keeps its ID, matched in order; other filters get a generated one.
+func buildDashboardFilterRules(ctx context.Context, planned types.List, liveRules []apiv1.DashboardFilterRuleV1) ([]apiv1.DashboardFilterRuleV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var filterModels []dashboardFilterModel
+	if !planned.IsNull() && !planned.IsUnknown() {
+		diags.Append(planned.ElementsAs(ctx, &filterModels, false)...)
+	}
+
+	liveIDs := map[apiv1.DashboardFilterTargetV1][]string{}
+	for _, rule := range liveRules {
+		liveIDs[rule.Target] = append(liveIDs[rule.Target], rule.ID)
+	}
+
+	rules := make([]apiv1.DashboardFilterRuleV1, 0, len(filterModels))
+	for _, filter := range filterModels {
+		var values []string
+		if !filter.Values.IsNull() {
+			diags.Append(filter.Values.ElementsAs(ctx, &values, false)...)
+		}
+		anyValues := make([]any, len(values))
+		for i, value := range values {
+			anyValues[i] = value
+		}
+		target := apiv1.DashboardFilterTargetV1{
+			FieldID:   filter.FieldID.ValueString(),
+			TableName: filter.TableName.ValueString(),
+		}
+		id := uuid.NewString()
+		if ids := liveIDs[target]; len(ids) > 0 {
+			id, liveIDs[target] = ids[0], ids[1:]
+		}
+		rules = append(rules, apiv1.DashboardFilterRuleV1{
+			ID:       id,
+			Label:    filter.Label.ValueStringPointer(),
+			Target:   target,
+			Operator: filter.Operator.ValueString(),
+			Values:   anyValues,
+			Disabled: filter.Disabled.ValueBool(),
+		})
+	}
+	return rules, diags
+}
+
+// reuseDashboardTileUUIDs gives planned tiles the UUID of the live tile they correspond to.
+// Tiles are matched first when unchanged, then by their content when they moved,
+// then by their position when their content changed. Each live tile is used at most once.
+func reuseDashboardTileUUIDs(tiles []apiv1.DashboardTileV1, liveTiles []apiv1.DashboardTileV1) {
+	used := make([]bool, len(liveTiles))
+	matchers := []func(a, b apiv1.DashboardTileV1) bool{
+		func(a, b apiv1.DashboardTileV1) bool {
+			return dashboardTileContentEqual(a, b) && dashboardTilePositionEqual(a, b)
+		},
+		dashboardTileContentEqual,
+		dashboardTilePositionEqual,
+	}
+	for _, matches := range matchers {
+		for i := range tiles {
+			if tiles[i].UUID != "" {
+				continue
+			}
+			for j, liveTile := range liveTiles {
+				if !used[j] && liveTile.UUID != "" && matches(tiles[i], liveTile) {
+					tiles[i].UUID = liveTile.UUID
+					used[j] = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// dashboardTileContentEqual reports whether both tiles show the same chart, markdown or video.
+func dashboardTileContentEqual(a, b apiv1.DashboardTileV1) bool {
+	return a.Type == b.Type &&
+		stringPointerValue(a.Properties.SavedChartUUID) == stringPointerValue(b.Properties.SavedChartUUID) &&
+		stringPointerValue(a.Properties.SavedSQLUUID) == stringPointerValue(b.Properties.SavedSQLUUID) &&
+		stringPointerValue(a.Properties.Content) == stringPointerValue(b.Properties.Content) &&
+		stringPointerValue(a.Properties.URL) == stringPointerValue(b.Properties.URL)
+}
+
+// dashboardTilePositionEqual reports whether both tiles have the same type and place on the same tab.
+func dashboardTilePositionEqual(a, b apiv1.DashboardTileV1) bool {
+	return a.Type == b.Type &&
+		stringPointerValue(a.TabUUID) == stringPointerValue(b.TabUUID) &&
+		a.X == b.X && a.Y == b.Y && a.W == b.W && a.H == b.H
+}
+
+func stringPointerValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// setDashboardResourceFromDashboard updates the model from the API response.
+// The configured tiles and filters are kept when they are semantically equal to the response,
+// so that defaults filled in by Lightdash do not produce a diff.
+func setDashboardResourceFromDashboard(ctx context.Context, model *dashboardResourceModel, dashboard apiv1.DashboardV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ProjectUUID = types.StringValue(dashboard.ProjectUUID)
+	model.SpaceUUID = types.StringValue(dashboard.SpaceUUID)
+	model.Name = types.StringValue(dashboard.Name)
+	if dashboard.Description != nil && (*dashboard.Description != "" || !model.Description.IsNull()) {
+		model.Description = types.StringValue(*dashboard.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+
+	apiTabs := make([]apiv1.DashboardTabV1, len(dashboard.Tabs))
+	copy(apiTabs, dashboard.Tabs)
+	sort.SliceStable(apiTabs, func(i, j int) bool { return apiTabs[i].Order < apiTabs[j].Order })
+	tabNames := make([]string, 0, len(apiTabs))
+	tabUUIDs := map[string]attr.Value{}
+	tabNamesByUUID := map[string]string{}
+	for _, tab := range apiTabs {
+		tabNames = append(tabNames, tab.Name)
+		tabUUIDs[tab.Name] = types.StringValue(tab.UUID)
+		tabNamesByUUID[tab.UUID] = tab.Name
+	}
+	if len(tabNames) > 0 || !model.Tabs.IsNull() {
+		tabs, tabsDiags := stringSliceToStringList(ctx, tabNames)
+		diags.Append(tabsDiags...)
+		model.Tabs = tabs
+	}
+	tabUUIDMap, mapDiags := types.MapValue(types.StringType, tabUUIDs)
+	diags.Append(mapDiags...)
+	model.TabUUIDs = tabUUIDMap
+
+	apiTiles := make([]dashboardTileModel, 0, len(dashboard.Tiles))
+	for _, tile := range dashboard.Tiles {
+		apiTiles = append(apiTiles, dashboardTileFromAPI(tile, tabNamesByUUID))
+	}
+	var stateTiles []dashboardTileModel
+	if !model.Tiles.IsNull() && !model.Tiles.IsUnknown() {
+		diags.Append(model.Tiles.ElementsAs(ctx, &stateTiles, false)...)
+	}
+	if !dashboardTilesEquivalent(stateTiles, apiTiles) {
+		if len(apiTiles) == 0 {
+			model.Tiles = types.SetNull(types.ObjectType{AttrTypes: dashboardTileAttrTypes})
+		} else {
+			tiles, tilesDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dashboardTileAttrTypes}, apiTiles)
+			diags.Append(tilesDiags...)
+			model.Tiles = tiles
+		}
+	}
+
+	var filtersDiags diag.Diagnostics
+	model.Filters, filtersDiags = dashboardFiltersFromAPI(ctx, model.Filters, dashboard.Filters.Dimensions)
+	diags.Append(filtersDiags...)
+	model.MetricFilters, filtersDiags = dashboardFiltersFromAPI(ctx, model.MetricFilters, dashboard.Filters.Metrics)
+	diags.Append(filtersDiags...)
+	model.TableCalcFilters, filtersDiags = dashboardFiltersFromAPI(ctx, model.TableCalcFilters, dashboard.Filters.TableCalculations)
+	diags.Append(filtersDiags...)
+
+	return diags
+}
+
+// dashboardFiltersFromAPI returns the filters of one kind read from the API.
+// The current value is kept when it is semantically equal to them.
+func dashboardFiltersFromAPI(ctx context.Context, current types.List, rules []apiv1.DashboardFilterRuleV1) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiFilters := make([]dashboardFilterModel, 0, len(rules))
+	for _, rule := range rules {
+		apiFilter, filterDiags := dashboardFilterFromAPI(ctx, rule)
+		diags.Append(filterDiags...)
+		apiFilters = append(apiFilters, apiFilter)
+	}
+	var currentFilters []dashboardFilterModel
+	if !current.IsNull() && !current.IsUnknown() {
+		diags.Append(current.ElementsAs(ctx, &currentFilters, false)...)
+	}
+	if !diags.HasError() && dashboardFiltersEquivalent(ctx, currentFilters, apiFilters) {
+		return current, diags
+	}
+	if len(apiFilters) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: dashboardFilterAttrTypes}), diags
+	}
+	filters, filtersDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dashboardFilterAttrTypes}, apiFilters)
+	diags.Append(filtersDiags...)
+	return filters, diags
+}
+
+func dashboardTileFromAPI(tile apiv1.DashboardTileV1, tabNamesByUUID map[string]string) dashboardTileModel {
+	tab := types.StringNull()
+	if tile.TabUUID != nil {
+		if name, ok := tabNamesByUUID[*tile.TabUUID]; ok {
+			tab = types.StringValue(name)
+		}
+	}
+	hideTitle := types.BoolNull()
+	if tile.Properties.HideTitle != nil && *tile.Properties.HideTitle {
+		hideTitle = types.BoolValue(true)
+	}
+	return dashboardTileModel{
+		Type:           types.StringValue(tile.Type),
+		X:              types.Int64Value(tile.X),
+		Y:              types.Int64Value(tile.Y),
+		Width:          types.Int64Value(tile.W),
+		Height:         types.Int64Value(tile.H),
+		Tab:            tab,
+		Title:          nonEmptyStringValue(tile.Properties.Title),
+		HideTitle:      hideTitle,
+		SavedChartUUID: nonEmptyStringValue(tile.Properties.SavedChartUUID),
+		SavedSQLUUID:   nonEmptyStringValue(tile.Properties.SavedSQLUUID),
+		Content:        nonEmptyStringValue(tile.Properties.Content),
+		URL:            nonEmptyStringValue(tile.Properties.URL),
+	}
+}
+
+func dashboardFilterFromAPI(ctx context.Context, filter apiv1.DashboardFilterRuleV1) (dashboardFilterModel, diag.Diagnostics) {
+	values := types.ListNull(types.StringType)
+	var diags diag.Diagnostics
+	if len(filter.Values) > 0 {
+		stringValues := make([]string, len(filter.Values))
+		for i, value := range filter.Values {
+			stringValues[i] = fmt.Sprint(value)
+		}
+		values, diags = stringSliceToStringList(ctx, stringValues)
+	}
+	disabled := types.BoolNull()
+	if filter.Disabled {
+		disabled = types.BoolValue(true)
+	}
+	return dashboardFilterModel{
+		FieldID:   types.StringValue(filter.Target.FieldID),
+		TableName: types.StringValue(filter.Target.TableName),
+		Operator:  types.StringValue(filter.Operator),
+		Values:    values,
+		Label:     nonEmptyStringValue(filter.Label),
+		Disabled:  disabled,
+	}, diags
+}
+
+func nonEmptyStringValue(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// dashboardTileKey is the normalized form of a tile used for semantic comparison.
+// Unset titles equal empty titles and an unset hide_title equals false.
+type dashboardTileKey struct {
+	Type, Tab, Title, SavedChartUUID, SavedSQLUUID, Content, URL string
+	X, Y, Width, Height                                          int64
+	HideTitle                                                    bool
+}
+
+func newDashboardTileKey(tile dashboardTileModel) dashboardTileKey {
+	return dashboardTileKey{
+		Type:           tile.Type.ValueString(),
+		Tab:            tile.Tab.ValueString(),
+		Title:          tile.Title.ValueString(),
+		SavedChartUUID: tile.SavedChartUUID.ValueString(),
+		SavedSQLUUID:   tile.SavedSQLUUID.ValueString(),
+		Content:        tile.Content.ValueString(),
+		URL:            tile.URL.ValueString(),
+		X:              tile.X.ValueInt64(),
+		Y:              tile.Y.ValueInt64(),
+		Width:          tile.Width.ValueInt64(),
+		Height:         tile.Height.ValueInt64(),
+		HideTitle:      tile.HideTitle.ValueBool(),
+	}
+}
+
+// dashboardTilesEquivalent reports whether both tile lists contain the same tiles regardless of order.
+func dashboardTilesEquivalent(a, b []dashboardTileModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := map[dashboardTileKey]int{}
+	for _, tile := range a {
+		counts[newDashboardTileKey(tile)]++
+	}
+	for _, tile := range b {
+		key := newDashboardTileKey(tile)
+		if counts[key] == 0 {
+			return false
+		}
+		counts[key]--
+	}
+	return true
+}
+
+// dashboardFiltersEquivalent reports whether both filter lists are equal in order,
+// treating unset labels, values and disabled flags as their empty values.
+// Filters whose values cannot be read are not equivalent.
+func dashboardFiltersEquivalent(ctx context.Context, a, b []dashboardFilterModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].FieldID.ValueString() != b[i].FieldID.ValueString() ||
+			a[i].TableName.ValueString() != b[i].TableName.ValueString() ||
+			a[i].Operator.ValueString() != b[i].Operator.ValueString() ||
+			a[i].Label.ValueString() != b[i].Label.ValueString() ||
+			a[i].Disabled.ValueBool() != b[i].Disabled.ValueBool() {
+			return false
+		}
+		var aValues, bValues []string
+		if !a[i].Values.IsNull() && !a[i].Values.IsUnknown() {
+			if diags := a[i].Values.ElementsAs(ctx, &aValues, false); diags.HasError() {
+				return false
+			}
+		}
+		if !b[i].Values.IsNull() && !b[i].Values.IsUnknown() {
+			if diags := b[i].Values.ElementsAs(ctx, &bValues, false); diags.HasError() {
+				return false
+			}
+		}
+		if strings.Join(aValues, "\x00") != strings.Join(bValues, "\x00") || len(aValues) != len(bValues) {
+			return false
+		}
+	}
+	return true
+}
+
+func getDashboardResourceID(projectUUID string, dashboardUUID string) string {
+	return fmt.Sprintf("projects/%s/dashboards/%s", projectUUID, dashboardUUID)
+}
+
+func extractDashboardResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/dashboards/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1]}, nil
+}
@@ -0,0 +1,336 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestExtractDashboardResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractDashboardResourceID("projects/project-uuid/dashboards/dashboard-uuid")
+	if err != nil {
+		t.Fatalf("extractDashboardResourceID: %v", err)
+	}
+	if got[0] != "project-uuid" {
+		t.Errorf("project UUID: got %q", got[0])
+	}
+	if got[1] != "dashboard-uuid" {
+		t.Errorf("dashboard UUID: got %q", got[1])
+	}
+
+	if _, err := extractDashboardResourceID("projects/project-uuid/spaces/space-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetDashboardResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getDashboardResourceID("project-uuid", "dashboard-uuid")
+	want := "projects/project-uuid/dashboards/dashboard-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateDashboardConfig(t *testing.T) {
+	t.Parallel()
+
+	tabs := []types.String{types.StringValue("Summary")}
+	tests := []struct {
+		name    string
+		tiles   []dashboardTileModel
+		wantErr bool
+	}{
+		{
+			name: "valid markdown tile",
+			tiles: []dashboardTileModel{
+				{Type: types.StringValue("markdown"), Width: types.Int64Value(36), Height: types.Int64Value(3), Tab: types.StringValue("Summary"), Content: types.StringValue("# Hello")},
+			},
+		},
+		{
+			name: "saved chart tile without chart",
+			tiles: []dashboardTileModel{
+				{Type: types.StringValue("saved_chart"), Width: types.Int64Value(18), Height: types.Int64Value(9)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown tab",
+			tiles: []dashboardTileModel{
+				{Type: types.StringValue("loom"), Width: types.Int64Value(18), Height: types.Int64Value(9), Tab: types.StringValue("Details"), URL: types.StringValue("https://www.loom.com/share/x")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid tile type",
+			tiles: []dashboardTileModel{
+				{Type: types.StringValue("heading"), Width: types.Int64Value(18), Height: types.Int64Value(9)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown chart is not validated",
+			tiles: []dashboardTileModel{
+				{Type: types.StringValue("saved_chart"), Width: types.Int64Value(18), Height: types.Int64Value(9), SavedChartUUID: types.StringUnknown()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		errs := validateDashboardConfig(tabs, test.tiles)
+		if (len(errs) > 0) != test.wantErr {
+			t.Errorf("%s: got errors %v, wantErr %v", test.name, errs, test.wantErr)
+		}
+	}
+
+	// Errors point at the tab or tile that caused them.
+	duplicatedTabs := []types.String{types.StringValue("Summary"), types.StringValue("Summary")}
+	invalidTiles := []dashboardTileModel{
+		{Type: types.StringValue("markdown"), Width: types.Int64Value(36), Height: types.Int64Value(3), Content: types.StringValue("# Hello")},
+		{Type: types.StringValue("saved_chart"), Width: types.Int64Value(18), Height: types.Int64Value(9)},
+	}
+	errs := validateDashboardConfig(duplicatedTabs, invalidTiles)
+	if len(errs) != 2 {
+		t.Fatalf("got errors %v, want 2", errs)
+	}
+	if errs[0].attribute != "tabs" || errs[0].index != 1 {
+		t.Errorf("duplicated tab error: got %s[%d]", errs[0].attribute, errs[0].index)
+	}
+	if errs[1].attribute != "tiles" || errs[1].index != 1 {
+		t.Errorf("tile error: got %s[%d]", errs[1].attribute, errs[1].index)
+	}
+}
+
+func TestDashboardTilesEquivalent(t *testing.T) {
+	t.Parallel()
+
+	chart := dashboardTileModel{
+		Type: types.StringValue("saved_chart"), X: types.Int64Value(0), Y: types.Int64Value(0),
+		Width: types.Int64Value(18), Height: types.Int64Value(9), SavedChartUUID: types.StringValue("chart-uuid"),
+		Title: types.StringNull(), HideTitle: types.BoolNull(),
+	}
+	markdown := dashboardTileModel{
+		Type: types.StringValue("markdown"), X: types.Int64Value(18), Y: types.Int64Value(0),
+		Width: types.Int64Value(18), Height: types.Int64Value(9), Content: types.StringValue("# Hello"),
+	}
+
+	// Reordered tiles and defaults filled in by Lightdash are equivalent.
+	chartFromAPI := chart
+	chartFromAPI.Title = types.StringValue("")
+	chartFromAPI.HideTitle = types.BoolValue(false)
+	if !dashboardTilesEquivalent([]dashboardTileModel{chart, markdown}, []dashboardTileModel{markdown, chartFromAPI}) {
+		t.Error("expected reordered tiles to be equivalent")
+	}
+
+	moved := markdown
+	moved.Y = types.Int64Value(9)
+	if dashboardTilesEquivalent([]dashboardTileModel{chart, markdown}, []dashboardTileModel{chart, moved}) {
+		t.Error("expected moved tile not to be equivalent")
+	}
+	if dashboardTilesEquivalent([]dashboardTileModel{chart, chart}, []dashboardTileModel{chart, markdown}) {
+		t.Error("expected duplicated tile not to be equivalent")
+	}
+}
+
+func TestDashboardFiltersEquivalent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	values, _ := stringSliceToStringList(ctx, []string{"completed"})
+	configured := dashboardFilterModel{
+		FieldID: types.StringValue("orders_status"), TableName: types.StringValue("orders"),
+		Operator: types.StringValue("equals"), Values: values, Label: types.StringNull(), Disabled: types.BoolValue(false),
+	}
+	fromAPI := configured
+	fromAPI.Disabled = types.BoolNull()
+	if !dashboardFiltersEquivalent(ctx, []dashboardFilterModel{configured}, []dashboardFilterModel{fromAPI}) {
+		t.Error("expected filters to be equivalent")
+	}
+
+	otherValues, _ := stringSliceToStringList(ctx, []string{"pending"})
+	fromAPI.Values = otherValues
+	if dashboardFiltersEquivalent(ctx, []dashboardFilterModel{configured}, []dashboardFilterModel{fromAPI}) {
+		t.Error("expected filters with different values not to be equivalent")
+	}
+
+	// Values that cannot be read as strings are never equivalent.
+	unreadable := configured
+	unreadable.Values = types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(1)})
+	if dashboardFiltersEquivalent(ctx, []dashboardFilterModel{unreadable}, []dashboardFilterModel{unreadable}) {
+		t.Error("expected unreadable filters not to be equivalent")
+	}
+}
+
+func TestReuseDashboardTileUUIDs(t *testing.T) {
+	t.Parallel()
+
+	chartUUID := "chart-uuid"
+	content := "# Hello"
+	edited := "# Hello, world"
+	added := "# Notes"
+	liveTiles := []apiv1.DashboardTileV1{
+		{UUID: "chart-tile", Type: "saved_chart", X: 0, Y: 0, W: 18, H: 9, Properties: apiv1.DashboardTilePropertiesV1{SavedChartUUID: &chartUUID}},
+		{UUID: "markdown-tile", Type: "markdown", X: 18, Y: 0, W: 18, H: 9, Properties: apiv1.DashboardTilePropertiesV1{Content: &content}},
+	}
+	tiles := []apiv1.DashboardTileV1{
+		// Moved chart
+		{Type: "saved_chart", X: 0, Y: 9, W: 18, H: 9, Properties: apiv1.DashboardTilePropertiesV1{SavedChartUUID: &chartUUID}},
+		// Edited markdown in place
+		{Type: "markdown", X: 18, Y: 0, W: 18, H: 9, Properties: apiv1.DashboardTilePropertiesV1{Content: &edited}},
+		// New tile
+		{Type: "markdown", X: 0, Y: 18, W: 36, H: 3, Properties: apiv1.DashboardTilePropertiesV1{Content: &added}},
+	}
+
+	reuseDashboardTileUUIDs(tiles, liveTiles)
+	want := []string{"chart-tile", "markdown-tile", ""}
+	for i := range tiles {
+		if tiles[i].UUID != want[i] {
+			t.Errorf("tile %d: got UUID %q, want %q", i, tiles[i].UUID, want[i])
+		}
+	}
+}
+
+func TestBuildDashboardFilterRules(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	planned, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dashboardFilterAttrTypes}, []dashboardFilterModel{
+		{FieldID: types.StringValue("orders_status"), TableName: types.StringValue("orders"), Operator: types.StringValue("equals"), Values: types.ListNull(types.StringType), Label: types.StringNull(), Disabled: types.BoolNull()},
+		{FieldID: types.StringValue("orders_amount"), TableName: types.StringValue("orders"), Operator: types.StringValue("isNull"), Values: types.ListNull(types.StringType), Label: types.StringNull(), Disabled: types.BoolNull()},
+	})
+	if diags.HasError() {
+		t.Fatalf("ListValueFrom: %v", diags)
+	}
+	live := []apiv1.DashboardFilterRuleV1{
+		{ID: "status-filter", Target: apiv1.DashboardFilterTargetV1{FieldID: "orders_status", TableName: "orders"}, Operator: "notEquals"},
+	}
+
+	rules, diags := buildDashboardFilterRules(ctx, planned, live)
+	if diags.HasError() {
+		t.Fatalf("buildDashboardFilterRules: %v", diags)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, want 2", len(rules))
+	}
+	if rules[0].ID != "status-filter" {
+		t.Errorf("expected the live filter ID to be kept, got %q", rules[0].ID)
+	}
+	if rules[1].ID == "" || rules[1].ID == "status-filter" {
+		t.Errorf("expected a new filter ID, got %q", rules[1].ID)
+	}
+}
+
+func TestAccDashboardResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_dashboard")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_dashboard", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_dashboard", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_dashboard.test", "name", "Dashboard (Acceptance Test: dashboard lifecycle)"),
+					resource.TestCheckResourceAttrSet("lightdash_dashboard.test", "dashboard_uuid"),
+					resource.TestCheckResourceAttr("lightdash_dashboard.test", "tiles.#", "1"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_dashboard.test", "name", "Dashboard (Acceptance Test: dashboard lifecycle updated)"),
+					resource.TestCheckResourceAttr("lightdash_dashboard.test", "tabs.#", "2"),
+					resource.TestCheckResourceAttrSet("lightdash_dashboard.test", "tab_uuids.Summary"),
+					resource.TestCheckResourceAttr("lightdash_dashboard.test", "tiles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDashboardResource_import(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_dashboard")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	importConfig, err := ReadAccTestResource([]string{"resources", "lightdash_dashboard", "import", "010_import.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get import config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + importConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_dashboard.test", "dashboard_uuid"),
+				),
+			},
+			{
+				Config:                  providerConfig + importConfig,
+				ResourceName:            "lightdash_dashboard.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					res, ok := state.RootModule().Resources["lightdash_dashboard.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state for import")
+					}
+					dashboardUUID, ok := res.Primary.Attributes["dashboard_uuid"]
+					if !ok || dashboardUUID == "" {
+						return "", fmt.Errorf("dashboard_uuid attribute not present in state")
+					}
+					// Import by the bare dashboard UUID.
+					return dashboardUUID, nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,346 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                = &embedConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &embedConfigurationResource{}
+	_ resource.ResourceWithImportState = &embedConfigurationResource{}
+	_ resource.ResourceWithModifyPlan  = &embedConfigurationResource{}
+)
+
+func NewEmbedConfigurationResource() resource.Resource {
+	return &embedConfigurationResource{}
+}
+
+type embedConfigurationResource struct {
+	client *api.Client
+}
+
+type embedConfigurationResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectUUID           types.String `tfsdk:"project_uuid"`
+	DashboardUUIDs        types.Set    `tfsdk:"dashboard_uuids"`
+	AllowAllDashboards    types.Bool   `tfsdk:"allow_all_dashboards"`
+	ChartUUIDs            types.Set    `tfsdk:"chart_uuids"`
+	AllowAllCharts        types.Bool   `tfsdk:"allow_all_charts"`
+	SecretRotationTrigger types.String `tfsdk:"secret_rotation_trigger"`
+	Secret                types.String `tfsdk:"secret"`
+	CreatedAt             types.String `tfsdk:"created_at"`
+}
+
+func (r *embedConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_embed_configuration"
+}
+
+func (r *embedConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_embed_configuration.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the embedding configuration of a Lightdash project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/embed_configuration`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dashboard_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the dashboards that can be embedded.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"allow_all_dashboards": schema.BoolAttribute{
+				MarkdownDescription: "Whether all dashboards of the project can be embedded. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"chart_uuids": schema.SetAttribute{
+				MarkdownDescription: "The UUIDs of the charts that can be embedded.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"allow_all_charts": schema.BoolAttribute{
+				MarkdownDescription: "Whether all charts of the project can be embedded. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"secret_rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value whose changes rotate the embed secret, such as a date.",
+				Optional:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The secret that signs embed JWTs for the project.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the current secret was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *embedConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan plans a new secret when the rotation trigger changes.
+func (r *embedConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan embedConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.SecretRotationTrigger.Equal(state.SecretRotationTrigger) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Planning rotation of the embed secret of project %s", state.ProjectUUID.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), types.StringUnknown())...)
+}
+
+func (r *embedConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan embedConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := embedContentFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID := plan.ProjectUUID.ValueString()
+	service := services.NewEmbedConfigurationService(r.client)
+	config, err := service.CreateEmbedConfiguration(ctx, projectUUID, content)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating embed configuration", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created embed configuration of project %s", projectUUID))
+
+	plan.ID = types.StringValue(getEmbedConfigurationResourceID(projectUUID))
+	resp.Diagnostics.Append(setEmbedConfigurationResourceFromConfig(ctx, &plan, config)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *embedConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state embedConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewEmbedConfigurationService(r.client)
+	config, err := service.GetEmbedConfiguration(ctx, state.ProjectUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrEmbedConfigurationNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Embed configuration of project %s not found, removing from state", state.ProjectUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading embed configuration", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setEmbedConfigurationResourceFromConfig(ctx, &state, config)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *embedConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan embedConfigurationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := embedContentFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID := plan.ProjectUUID.ValueString()
+	service := services.NewEmbedConfigurationService(r.client)
+	var config *apiv1.EmbedConfigV1
+	var err error
+	// The secret is only unknown when ModifyPlan planned a rotation.
+	if plan.Secret.IsUnknown() {
+		config, err = service.CreateEmbedConfiguration(ctx, projectUUID, content)
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating embed secret", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated the embed secret of project %s", projectUUID))
+	} else {
+		config, err = service.UpdateEmbedContent(ctx, projectUUID, content)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating embed configuration", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Updated embed configuration of project %s", projectUUID))
+	}
+	resp.Diagnostics.Append(setEmbedConfigurationResourceFromConfig(ctx, &plan, config)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes all embeddable content, since Lightdash cannot delete the embedding configuration of a project.
+func (r *embedConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state embedConfigurationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewEmbedConfigurationService(r.client)
+	if err := service.DisableEmbedConfiguration(ctx, state.ProjectUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting embed configuration", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Removed the embeddable content of project %s", state.ProjectUUID.ValueString()))
+}
+
+func (r *embedConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractEmbedConfigurationResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	projectUUID := extracted[0]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUUID)...)
+}
+
+func embedContentFromModel(ctx context.Context, model *embedConfigurationResourceModel) (apiv1.EmbedContentV1, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	content := apiv1.EmbedContentV1{
+		AllowAllDashboards: model.AllowAllDashboards.ValueBool(),
+		AllowAllCharts:     model.AllowAllCharts.ValueBool(),
+	}
+	diags.Append(model.DashboardUUIDs.ElementsAs(ctx, &content.DashboardUUIDs, false)...)
+	diags.Append(model.ChartUUIDs.ElementsAs(ctx, &content.ChartUUIDs, false)...)
+	sort.Strings(content.DashboardUUIDs)
+	sort.Strings(content.ChartUUIDs)
+	return content, diags
+}
+
+func setEmbedConfigurationResourceFromConfig(ctx context.Context, model *embedConfigurationResourceModel, config *apiv1.EmbedConfigV1) diag.Diagnostics {
+	var diags diag.Diagnostics
+	dashboardUUIDs, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(config.DashboardUUIDs))
+	diags.Append(d...)
+	chartUUIDs, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(config.ChartUUIDs))
+	diags.Append(d...)
+
+	model.DashboardUUIDs = dashboardUUIDs
+	model.AllowAllDashboards = types.BoolValue(config.AllowAllDashboards)
+	model.ChartUUIDs = chartUUIDs
+	model.AllowAllCharts = types.BoolValue(config.AllowAllCharts)
+	model.Secret = types.StringValue(config.EncodedSecret)
+	model.CreatedAt = types.StringValue(config.CreatedAt)
+	return diags
+}
+
+// nonNilStrings turns a missing list into an empty one, so that it matches an empty set in the configuration.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func getEmbedConfigurationResourceID(projectUUID string) string {
+	return fmt.Sprintf("projects/%s/embed_configuration", projectUUID)
+}
+
+func extractEmbedConfigurationResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/embed_configuration$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0]}, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestEmbedConfigurationResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getEmbedConfigurationResourceID("project-uuid")
+	if id != "projects/project-uuid/embed_configuration" {
+		t.Errorf("unexpected resource ID: %s", id)
+	}
+
+	extracted, err := extractEmbedConfigurationResourceID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if extracted[0] != "project-uuid" {
+		t.Errorf("got %q, want %q", extracted[0], "project-uuid")
+	}
+
+	if _, err := extractEmbedConfigurationResourceID("projects/project-uuid"); err == nil {
+		t.Error("expected an error for an invalid resource ID")
+	}
+}
+
+func TestEmbedConfigurationModelRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var model embedConfigurationResourceModel
+	diags := setEmbedConfigurationResourceFromConfig(ctx, &model, &apiv1.EmbedConfigV1{
+		ProjectUUID:    "project-uuid",
+		EncodedSecret:  "embed-secret",
+		DashboardUUIDs: []string{"dashboard-2", "dashboard-1"},
+		AllowAllCharts: true,
+		CreatedAt:      "2024-01-01T00:00:00.000Z",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// A missing list is read as an empty set, which matches the default.
+	if model.ChartUUIDs.IsNull() || len(model.ChartUUIDs.Elements()) != 0 {
+		t.Errorf("expected an empty set of charts, got %v", model.ChartUUIDs)
+	}
+	if model.Secret != types.StringValue("embed-secret") {
+		t.Errorf("unexpected secret: %v", model.Secret)
+	}
+
+	content, diags := embedContentFromModel(ctx, &model)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(content.DashboardUUIDs) != 2 || content.DashboardUUIDs[0] != "dashboard-1" {
+		t.Errorf("unexpected dashboards: %v", content.DashboardUUIDs)
+	}
+	if content.AllowAllDashboards || !content.AllowAllCharts {
+		t.Errorf("unexpected allow all flags: %+v", content)
+	}
+}
+
+func TestAccEmbedConfigurationResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_embed_configuration")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_embed_configuration", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	rotateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_embed_configuration", "lifecycle", "020_rotate_secret.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get rotate config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_embed_configuration.test", "secret"),
+					resource.TestCheckResourceAttr("lightdash_embed_configuration.test", "allow_all_dashboards", "true"),
+					resource.TestCheckResourceAttr("lightdash_embed_configuration.test", "dashboard_uuids.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + rotateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_embed_configuration.test", "secret"),
+					resource.TestCheckResourceAttr("lightdash_embed_configuration.test", "allow_all_charts", "true"),
+				),
+			},
+			{
+				ResourceName:            "lightdash_embed_configuration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_rotation_trigger"},
+			},
+		},
+	})
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &inviteLinkResource{}
+	_ resource.ResourceWithConfigure      = &inviteLinkResource{}
+	_ resource.ResourceWithValidateConfig = &inviteLinkResource{}
+)
+
+func NewInviteLinkResource() resource.Resource {
+	return &inviteLinkResource{}
+}
+
+type inviteLinkResource struct {
+	client *api.Client
+}
+
+type inviteLinkResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Email            types.String `tfsdk:"email"`
+	Role             types.String `tfsdk:"role"`
+	ExpirationDays   types.Int64  `tfsdk:"expiration_days"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+	UserUUID         types.String `tfsdk:"user_uuid"`
+	InviteCode       types.String `tfsdk:"invite_code"`
+	InviteURL        types.String `tfsdk:"invite_url"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+}
+
+func (r *inviteLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invite_link"
+}
+
+func (r *inviteLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_invite_link.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Creates a Lightdash invite link for an email",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/invite_links/<user_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the invited user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The organization role the user gets when accepting the invite, such as `member` or `viewer`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of days the invite link is valid. Defaults to `%d`.", defaultInviteExpirationDays),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultInviteExpirationDays),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization the user is invited to.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the invited user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_code": schema.StringAttribute{
+				MarkdownDescription: "The code of the invite link.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_url": schema.StringAttribute{
+				MarkdownDescription: "The URL the user opens to accept the invite.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the invite link expires.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *inviteLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *inviteLinkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config inviteLinkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, err := range validateInviteLinkConfig(config.Email, config.Role, config.ExpirationDays) {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid invite link", err.Error())
+	}
+}
+
+func (r *inviteLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan inviteLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	invite, err := service.InviteOrganizationMember(
+		ctx,
+		plan.Email.ValueString(),
+		models.OrganizationMemberRole(plan.Role.ValueString()),
+		inviteExpiration(plan.ExpirationDays),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating invite link", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created invite link for %s", invite.Email))
+
+	plan.ID = types.StringValue(getInviteLinkResourceID(invite.OrganizationUUID, invite.UserUUID))
+	plan.OrganizationUUID = types.StringValue(invite.OrganizationUUID)
+	plan.UserUUID = types.StringValue(invite.UserUUID)
+	plan.InviteCode = types.StringValue(invite.InviteCode)
+	plan.InviteURL = types.StringValue(invite.InviteURL)
+	plan.ExpiresAt = types.StringValue(invite.ExpiresAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is. An invite link is a one-off value that stops working once it is used or expires.
+func (r *inviteLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state inviteLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes because every configurable attribute requires replacement.
+func (r *inviteLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan inviteLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state. Lightdash can only revoke all invite links of an organization at once,
+// so the invite link stays valid until it is used or expires.
+func (r *inviteLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state inviteLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing invite link for %s from state; it stays valid until %s", state.Email.ValueString(), state.ExpiresAt.ValueString()))
+}
+
+// validateInviteLinkConfig validates the settings of an invite and returns the errors keyed by attribute name.
+func validateInviteLinkConfig(email types.String, role types.String, expirationDays types.Int64) map[string]error {
+	errs := map[string]error{}
+	if !email.IsNull() && !email.IsUnknown() && !strings.Contains(email.ValueString(), "@") {
+		errs["email"] = fmt.Errorf("email %q is not an email address", email.ValueString())
+	}
+	if !role.IsNull() && !role.IsUnknown() && !models.OrganizationMemberRole(role.ValueString()).IsValid() {
+		errs["role"] = fmt.Errorf("role %q is not a valid organization role", role.ValueString())
+	}
+	if !expirationDays.IsNull() && !expirationDays.IsUnknown() && expirationDays.ValueInt64() < 1 {
+		errs["expiration_days"] = fmt.Errorf("expiration_days must be at least 1")
+	}
+	return errs
+}
+
+func getInviteLinkResourceID(organizationUUID string, userUUID string) string {
+	return fmt.Sprintf("organizations/%s/invite_links/%s", organizationUUID, userUUID)
+}
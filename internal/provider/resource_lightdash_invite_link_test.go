@@ -0,0 +1,84 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGetInviteLinkResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getInviteLinkResourceID("org-uuid", "user-uuid")
+	want := "organizations/org-uuid/invite_links/user-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateInviteLinkConfig(t *testing.T) {
+	t.Parallel()
+
+	errs := validateInviteLinkConfig(types.StringValue("user@example.com"), types.StringValue("viewer"), types.Int64Value(3))
+	if len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	errs = validateInviteLinkConfig(types.StringUnknown(), types.StringUnknown(), types.Int64Null())
+	if len(errs) != 0 {
+		t.Errorf("expected unknown values to be skipped, got %v", errs)
+	}
+
+	errs = validateInviteLinkConfig(types.StringValue("user"), types.StringValue("owner"), types.Int64Value(0))
+	for _, attribute := range []string{"email", "role", "expiration_days"} {
+		if _, ok := errs[attribute]; !ok {
+			t.Errorf("expected an error for %s, got %v", attribute, errs)
+		}
+	}
+}
+
+func TestAccInviteLinkResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_invite_link")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_invite_link", "create", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_invite_link.test", "user_uuid"),
+					resource.TestCheckResourceAttrSet("lightdash_invite_link.test", "invite_url"),
+					resource.TestCheckResourceAttrSet("lightdash_invite_link.test", "expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,316 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &organizationColorPaletteResource{}
+	_ resource.ResourceWithConfigure      = &organizationColorPaletteResource{}
+	_ resource.ResourceWithImportState    = &organizationColorPaletteResource{}
+	_ resource.ResourceWithValidateConfig = &organizationColorPaletteResource{}
+)
+
+var hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func NewOrganizationColorPaletteResource() resource.Resource {
+	return &organizationColorPaletteResource{}
+}
+
+type organizationColorPaletteResource struct {
+	client *api.Client
+}
+
+type organizationColorPaletteResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationUUID types.String `tfsdk:"organization_uuid"`
+	ColorPaletteUUID types.String `tfsdk:"color_palette_uuid"`
+	Name             types.String `tfsdk:"name"`
+	Colors           types.List   `tfsdk:"colors"`
+	IsActive         types.Bool   `tfsdk:"is_active"`
+}
+
+func (r *organizationColorPaletteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_color_palette"
+}
+
+func (r *organizationColorPaletteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_organization_color_palette.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash organization chart color palette",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/color_palettes/<color_palette_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization owning the palette.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color_palette_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the color palette.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the color palette.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"colors": schema.ListAttribute{
+				MarkdownDescription: "The ordered list of hex colors of the palette, such as `#1A1A2E`. Charts use the colors in this order.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the palette is the one used for charts across the organization. Set to `true` to activate the palette. When unset, the palette is not activated by Terraform.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationColorPaletteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *organizationColorPaletteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationColorPaletteResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Colors.IsNull() || config.Colors.IsUnknown() {
+		return
+	}
+
+	var colors []types.String
+	resp.Diagnostics.Append(config.Colors.ElementsAs(ctx, &colors, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, err := range validateColorPaletteColors(colors) {
+		resp.Diagnostics.AddAttributeError(path.Root("colors"), "Invalid color palette", err.Error())
+	}
+}
+
+func (r *organizationColorPaletteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationColorPaletteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var colors []string
+	resp.Diagnostics.Append(plan.Colors.ElementsAs(ctx, &colors, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewColorPaletteService(r.client)
+	palette, err := service.CreateColorPalette(ctx, plan.Name.ValueString(), colors, plan.IsActive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating color palette", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created color palette %s", palette.ColorPaletteUUID))
+
+	plan.ID = types.StringValue(getOrganizationColorPaletteResourceID(palette.OrganizationUUID, palette.ColorPaletteUUID))
+	resp.Diagnostics.Append(setOrganizationColorPaletteResourceFromPalette(ctx, &plan, *palette)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *organizationColorPaletteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationColorPaletteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewColorPaletteService(r.client)
+	palette, err := service.GetColorPalette(ctx, state.ColorPaletteUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrColorPaletteNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Color palette %s not found, removing from state", state.ColorPaletteUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading color palette", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setOrganizationColorPaletteResourceFromPalette(ctx, &state, *palette)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *organizationColorPaletteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state organizationColorPaletteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lightdash always has an active palette, so a palette can only be deactivated by activating another one.
+	if !plan.IsActive.IsUnknown() && !plan.IsActive.ValueBool() && state.IsActive.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_active"),
+			"Cannot deactivate color palette",
+			"The color palette is active. Activate another color palette instead of setting is_active to false.",
+		)
+		return
+	}
+
+	var colors []string
+	resp.Diagnostics.Append(plan.Colors.ElementsAs(ctx, &colors, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewColorPaletteService(r.client)
+	palette, err := service.UpdateColorPalette(ctx, state.ColorPaletteUUID.ValueString(), plan.Name.ValueString(), colors, plan.IsActive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating color palette", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setOrganizationColorPaletteResourceFromPalette(ctx, &plan, *palette)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *organizationColorPaletteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationColorPaletteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewColorPaletteService(r.client)
+	if err := service.DeleteColorPalette(ctx, state.ColorPaletteUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting color palette", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted color palette %s", state.ColorPaletteUUID.ValueString()))
+}
+
+func (r *organizationColorPaletteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractOrganizationColorPaletteResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	organizationUUID := extracted[0]
+	colorPaletteUUID := extracted[1]
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUUID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("color_palette_uuid"), colorPaletteUUID)...)
+}
+
+func validateColorPaletteColors(colors []types.String) []error {
+	var errs []error
+	if len(colors) == 0 {
+		errs = append(errs, fmt.Errorf("colors must contain at least one color"))
+	}
+	for i, color := range colors {
+		if color.IsNull() || color.IsUnknown() {
+			continue
+		}
+		if !hexColorPattern.MatchString(color.ValueString()) {
+			errs = append(errs, fmt.Errorf("colors[%d]: %q is not a hex color such as #1A1A2E", i, color.ValueString()))
+		}
+	}
+	return errs
+}
+
+func setOrganizationColorPaletteResourceFromPalette(ctx context.Context, model *organizationColorPaletteResourceModel, palette apiv1.ColorPaletteV1) diag.Diagnostics {
+	model.OrganizationUUID = types.StringValue(palette.OrganizationUUID)
+	model.ColorPaletteUUID = types.StringValue(palette.ColorPaletteUUID)
+	model.Name = types.StringValue(palette.Name)
+	model.IsActive = types.BoolValue(palette.IsActive)
+	colors, diags := types.ListValueFrom(ctx, types.StringType, palette.Colors)
+	model.Colors = colors
+	return diags
+}
+
+func getOrganizationColorPaletteResourceID(organizationUUID string, colorPaletteUUID string) string {
+	return fmt.Sprintf("organizations/%s/color_palettes/%s", organizationUUID, colorPaletteUUID)
+}
+
+func extractOrganizationColorPaletteResourceID(input string) ([]string, error) {
+	pattern := `^organizations/([^/]+)/color_palettes/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1]}, nil
+}
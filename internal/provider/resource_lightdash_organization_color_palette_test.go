@@ -0,0 +1,153 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestExtractOrganizationColorPaletteResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractOrganizationColorPaletteResourceID("organizations/org-uuid/color_palettes/palette-uuid")
+	if err != nil {
+		t.Fatalf("extractOrganizationColorPaletteResourceID: %v", err)
+	}
+	if got[0] != "org-uuid" {
+		t.Errorf("organization UUID: got %q", got[0])
+	}
+	if got[1] != "palette-uuid" {
+		t.Errorf("color palette UUID: got %q", got[1])
+	}
+
+	if _, err := extractOrganizationColorPaletteResourceID("palette-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetOrganizationColorPaletteResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getOrganizationColorPaletteResourceID("org-uuid", "palette-uuid")
+	want := "organizations/org-uuid/color_palettes/palette-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateColorPaletteColors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		colors   []types.String
+		wantErrs int
+	}{
+		{
+			name:   "valid",
+			colors: []types.String{types.StringValue("#1A1A2E"), types.StringValue("#fff"), types.StringUnknown()},
+		},
+		{
+			name:     "empty",
+			colors:   []types.String{},
+			wantErrs: 1,
+		},
+		{
+			name:     "invalid colors",
+			colors:   []types.String{types.StringValue("1A1A2E"), types.StringValue("#12345"), types.StringValue("red")},
+			wantErrs: 3,
+		},
+	}
+
+	for _, test := range tests {
+		if errs := validateColorPaletteColors(test.colors); len(errs) != test.wantErrs {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.wantErrs, errs)
+		}
+	}
+}
+
+func TestSetOrganizationColorPaletteResourceFromPalette(t *testing.T) {
+	t.Parallel()
+
+	var model organizationColorPaletteResourceModel
+	diags := setOrganizationColorPaletteResourceFromPalette(context.Background(), &model, apiv1.ColorPaletteV1{
+		ColorPaletteUUID: "palette-uuid",
+		OrganizationUUID: "org-uuid",
+		Name:             "Corporate",
+		Colors:           []string{"#1A1A2E", "#16213E"},
+		IsActive:         true,
+	})
+	if diags.HasError() {
+		t.Fatalf("setOrganizationColorPaletteResourceFromPalette: %v", diags)
+	}
+	if model.Name.ValueString() != "Corporate" || !model.IsActive.ValueBool() {
+		t.Errorf("unexpected model: %+v", model)
+	}
+	if len(model.Colors.Elements()) != 2 {
+		t.Errorf("expected 2 colors, got %d", len(model.Colors.Elements()))
+	}
+}
+
+func TestAccOrganizationColorPaletteResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_organization_color_palette")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_organization_color_palette", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_organization_color_palette", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_organization_color_palette.test", "name", "Palette (Acceptance Test: color palette lifecycle)"),
+					resource.TestCheckResourceAttr("lightdash_organization_color_palette.test", "colors.#", "3"),
+					resource.TestCheckResourceAttrSet("lightdash_organization_color_palette.test", "color_palette_uuid"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_organization_color_palette.test", "colors.#", "2"),
+					resource.TestCheckResourceAttr("lightdash_organization_color_palette.test", "colors.0", "#0F3460"),
+				),
+			},
+			{
+				ResourceName:      "lightdash_organization_color_palette.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
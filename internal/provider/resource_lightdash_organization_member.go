@@ -0,0 +1,420 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &organizationMemberResource{}
+	_ resource.ResourceWithConfigure      = &organizationMemberResource{}
+	_ resource.ResourceWithImportState    = &organizationMemberResource{}
+	_ resource.ResourceWithModifyPlan     = &organizationMemberResource{}
+	_ resource.ResourceWithValidateConfig = &organizationMemberResource{}
+)
+
+// The number of days an invite is valid by default.
+const defaultInviteExpirationDays = 7
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &organizationMemberResource{}
+}
+
+type organizationMemberResource struct {
+	client      *api.Client
+	roleService *services.RoleService
+}
+
+type organizationMemberResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	OrganizationUUID     types.String `tfsdk:"organization_uuid"`
+	Email                types.String `tfsdk:"email"`
+	Role                 types.String `tfsdk:"role"`
+	UserUUID             types.String `tfsdk:"user_uuid"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	IsInviteExpired      types.Bool   `tfsdk:"is_invite_expired"`
+	ResendExpiredInvite  types.Bool   `tfsdk:"resend_expired_invite"`
+	InviteExpirationDays types.Int64  `tfsdk:"invite_expiration_days"`
+	DeleteProtection     types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (r *organizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *organizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_organization_member.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Invites a user to a Lightdash organization and manages their membership",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/members/<user_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization. It must match the organization of the configured API token.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address the invite is sent to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The organization role of the user, such as `member`, `viewer` or `admin`.",
+				Required:            true,
+			},
+			"user_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash user.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has accepted the invite and their account is active.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_invite_expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the invite expired before the user accepted it.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resend_expired_invite": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, an expired invite is sent again on the next apply. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"invite_expiration_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of days an invite is valid. Defaults to `%d`.", defaultInviteExpirationDays),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultInviteExpirationDays),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, prevents Terraform from deleting the user.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *organizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.roleService = services.GetRoleService(client)
+}
+
+func (r *organizationMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationMemberResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Email.IsNull() && !config.Email.IsUnknown() && !strings.Contains(config.Email.ValueString(), "@") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Invalid email",
+			fmt.Sprintf("email %q is not an email address", config.Email.ValueString()),
+		)
+	}
+	if !config.Role.IsNull() && !config.Role.IsUnknown() && !models.OrganizationMemberRole(config.Role.ValueString()).IsValid() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role"),
+			"Invalid role",
+			fmt.Sprintf("role %q is not a valid organization role", config.Role.ValueString()),
+		)
+	}
+	if !config.InviteExpirationDays.IsNull() && !config.InviteExpirationDays.IsUnknown() && config.InviteExpirationDays.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("invite_expiration_days"),
+			"Invalid invite expiration",
+			"invite_expiration_days must be at least 1",
+		)
+	}
+}
+
+// ModifyPlan plans a new invite when the previous one expired and resend_expired_invite is set.
+func (r *organizationMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan organizationMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if needsInviteResend(state, plan) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_invite_expired"), false)...)
+	}
+}
+
+func (r *organizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, plan.OrganizationUUID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	existing, err := service.FindOrganizationMemberByEmail(ctx, plan.Email.ValueString())
+	if err != nil && !errors.Is(err, services.ErrOrganizationMemberNotFound) {
+		resp.Diagnostics.AddError("Error looking up organization member", err.Error())
+		return
+	}
+	if existing != nil && existing.IsActive {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Organization member already exists",
+			fmt.Sprintf("%s is already an active member of the organization. Import it with the ID %q instead.", existing.Email, getOrganizationMemberResourceID(existing.OrganizationUUID, existing.UserUUID)),
+		)
+		return
+	}
+
+	invite, err := service.InviteOrganizationMember(ctx, plan.Email.ValueString(), models.OrganizationMemberRole(plan.Role.ValueString()), inviteExpiration(plan.InviteExpirationDays))
+	if err != nil {
+		resp.Diagnostics.AddError("Error inviting organization member", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Invited %s to organization %s", invite.Email, plan.OrganizationUUID.ValueString()))
+
+	member, err := service.GetOrganizationMember(ctx, invite.UserUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", err.Error())
+		return
+	}
+	plan.ID = types.StringValue(getOrganizationMemberResourceID(plan.OrganizationUUID.ValueString(), member.UserUUID))
+	setOrganizationMemberResourceFromMember(&plan, *member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *organizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	member, err := service.GetOrganizationMember(ctx, state.UserUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrOrganizationMemberNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Organization member %s not found, removing from state", state.UserUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading organization member", err.Error())
+		return
+	}
+	setOrganizationMemberResourceFromMember(&state, *member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *organizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state organizationMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	role := models.OrganizationMemberRole(plan.Role.ValueString())
+	if needsInviteResend(state, plan) {
+		// The new invite carries the planned role, so no separate role change is needed.
+		if _, err := service.InviteOrganizationMember(ctx, plan.Email.ValueString(), role, inviteExpiration(plan.InviteExpirationDays)); err != nil {
+			resp.Diagnostics.AddError("Error resending invite", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Resent expired invite to %s", plan.Email.ValueString()))
+	} else if plan.Role.ValueString() != state.Role.ValueString() {
+		if _, err := r.roleService.AssignOrgUserRole(ctx, plan.OrganizationUUID.ValueString(), state.UserUUID.ValueString(), role.String()); err != nil {
+			resp.Diagnostics.AddError("Error updating organization role", err.Error())
+			return
+		}
+		service.InvalidateCache()
+	}
+
+	member, err := service.GetOrganizationMember(ctx, state.UserUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member", err.Error())
+		return
+	}
+	setOrganizationMemberResourceFromMember(&plan, *member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *organizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeleteProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion protection enabled",
+			"Cannot delete organization member because deletion_protection is set to true. Set deletion_protection to false to allow deletion.",
+		)
+		return
+	}
+
+	service := services.GetOrganizationMembersService(r.client)
+	if err := service.DeleteOrganizationMember(ctx, state.UserUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting organization member", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted organization member %s", state.Email.ValueString()))
+}
+
+func (r *organizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractOrganizationMemberResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	organizationUUID := extracted[0]
+	userUUID := extracted[1]
+
+	service := services.GetOrganizationMembersService(r.client)
+	member, err := service.GetOrganizationMember(ctx, userUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization member for import", err.Error())
+		return
+	}
+	if member.OrganizationUUID != organizationUUID {
+		resp.Diagnostics.AddError(
+			"Organization UUID mismatch",
+			fmt.Sprintf("user %s belongs to organization %s, not %s", userUUID, member.OrganizationUUID, organizationUUID),
+		)
+		return
+	}
+
+	state := organizationMemberResourceModel{
+		ID:                   types.StringValue(req.ID),
+		OrganizationUUID:     types.StringValue(organizationUUID),
+		ResendExpiredInvite:  types.BoolValue(true),
+		InviteExpirationDays: types.Int64Value(defaultInviteExpirationDays),
+		DeleteProtection:     types.BoolValue(true),
+	}
+	setOrganizationMemberResourceFromMember(&state, *member)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// needsInviteResend reports whether the invite expired before it was accepted and should be sent again.
+func needsInviteResend(state organizationMemberResourceModel, plan organizationMemberResourceModel) bool {
+	return state.IsInviteExpired.ValueBool() && !state.IsActive.ValueBool() && plan.ResendExpiredInvite.ValueBool()
+}
+
+// inviteExpiration converts a number of days into the lifetime of an invite, falling back to the default.
+func inviteExpiration(expirationDays types.Int64) time.Duration {
+	days := expirationDays.ValueInt64()
+	if days < 1 {
+		days = defaultInviteExpirationDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func setOrganizationMemberResourceFromMember(model *organizationMemberResourceModel, member apiv1.GetOrganizationMembersV1Results) {
+	model.OrganizationUUID = types.StringValue(member.OrganizationUUID)
+	model.UserUUID = types.StringValue(member.UserUUID)
+	// Lightdash may change the case of the email, which is not a change of user.
+	if !strings.EqualFold(model.Email.ValueString(), member.Email) {
+		model.Email = types.StringValue(member.Email)
+	}
+	model.Role = types.StringValue(member.OrganizationRole.String())
+	model.IsActive = types.BoolValue(member.IsActive)
+	model.IsInviteExpired = types.BoolValue(member.IsInviteExpired)
+}
+
+func getOrganizationMemberResourceID(organizationUUID string, userUUID string) string {
+	return fmt.Sprintf("organizations/%s/members/%s", organizationUUID, userUUID)
+}
+
+func extractOrganizationMemberResourceID(input string) ([]string, error) {
+	pattern := `^organizations/([^/]+)/members/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1]}, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestExtractOrganizationMemberResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractOrganizationMemberResourceID("organizations/org-uuid/members/user-uuid")
+	if err != nil {
+		t.Fatalf("extractOrganizationMemberResourceID: %v", err)
+	}
+	if got[0] != "org-uuid" {
+		t.Errorf("organization UUID: got %q", got[0])
+	}
+	if got[1] != "user-uuid" {
+		t.Errorf("user UUID: got %q", got[1])
+	}
+
+	if _, err := extractOrganizationMemberResourceID("organizations/org-uuid/users/user-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetOrganizationMemberResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getOrganizationMemberResourceID("org-uuid", "user-uuid")
+	want := "organizations/org-uuid/members/user-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNeedsInviteResend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		isActive        bool
+		isInviteExpired bool
+		resend          bool
+		expected        bool
+	}{
+		{name: "expired invite", isInviteExpired: true, resend: true, expected: true},
+		{name: "resend disabled", isInviteExpired: true, resend: false, expected: false},
+		{name: "pending invite", isInviteExpired: false, resend: true, expected: false},
+		{name: "active user", isActive: true, isInviteExpired: true, resend: true, expected: false},
+	}
+
+	for _, test := range tests {
+		state := organizationMemberResourceModel{
+			IsActive:        types.BoolValue(test.isActive),
+			IsInviteExpired: types.BoolValue(test.isInviteExpired),
+		}
+		plan := organizationMemberResourceModel{
+			ResendExpiredInvite: types.BoolValue(test.resend),
+		}
+		if got := needsInviteResend(state, plan); got != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestInviteExpiration(t *testing.T) {
+	t.Parallel()
+
+	got := inviteExpiration(types.Int64Value(3))
+	if got != 72*time.Hour {
+		t.Errorf("expected 72h, got %s", got)
+	}
+	got = inviteExpiration(types.Int64Null())
+	if got != defaultInviteExpirationDays*24*time.Hour {
+		t.Errorf("expected the default expiration, got %s", got)
+	}
+}
+
+func TestSetOrganizationMemberResourceFromMember(t *testing.T) {
+	t.Parallel()
+
+	model := organizationMemberResourceModel{Email: types.StringValue("User@Example.com")}
+	setOrganizationMemberResourceFromMember(&model, apiv1.GetOrganizationMembersV1Results{
+		OrganizationUUID: "org-uuid",
+		UserUUID:         "user-uuid",
+		Email:            "user@example.com",
+		OrganizationRole: models.ORGANIZATION_VIEWER_ROLE,
+		IsActive:         false,
+		IsInviteExpired:  true,
+	})
+	if model.Email.ValueString() != "User@Example.com" {
+		t.Errorf("expected the configured email to be kept, got %q", model.Email.ValueString())
+	}
+	if model.Role.ValueString() != "viewer" || model.IsActive.ValueBool() || !model.IsInviteExpired.ValueBool() {
+		t.Errorf("unexpected model: %+v", model)
+	}
+}
+
+func TestAccOrganizationMemberResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_organization_member")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_organization_member", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_organization_member", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_organization_member.test", "user_uuid"),
+					resource.TestCheckResourceAttr("lightdash_organization_member.test", "role", "viewer"),
+					resource.TestCheckResourceAttr("lightdash_organization_member.test", "is_active", "false"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_organization_member.test", "role", "editor"),
+				),
+			},
+		},
+	})
+}
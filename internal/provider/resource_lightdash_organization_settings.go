@@ -0,0 +1,253 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                = &organizationSettingsResource{}
+	_ resource.ResourceWithConfigure   = &organizationSettingsResource{}
+	_ resource.ResourceWithImportState = &organizationSettingsResource{}
+)
+
+func NewOrganizationSettingsResource() resource.Resource {
+	return &organizationSettingsResource{}
+}
+
+type organizationSettingsResource struct {
+	client *api.Client
+}
+
+type organizationSettingsResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	OrganizationUUID   types.String `tfsdk:"organization_uuid"`
+	Name               types.String `tfsdk:"name"`
+	DefaultProjectUUID types.String `tfsdk:"default_project_uuid"`
+	ColorPaletteUUID   types.String `tfsdk:"color_palette_uuid"`
+}
+
+func (r *organizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+func (r *organizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_organization_settings.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the settings of a Lightdash organization",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization. It must match the organization of the configured API token.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"default_project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project users land on by default. When unset, the current default project is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color_palette_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the color palette used for charts. When unset, the current palette is kept.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *organizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *organizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, plan.OrganizationUUID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := services.UpdateOrganization(ctx, r.client, buildUpdateOrganizationRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization settings", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Updated settings of organization %s", organization.OrganizationUUID))
+
+	plan.ID = types.StringValue(getOrganizationSettingsResourceID(organization.OrganizationUUID))
+	setOrganizationSettingsResourceFromOrganization(&plan, *organization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *organizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := services.GetOrganization(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading organization settings", err.Error())
+		return
+	}
+	setOrganizationSettingsResourceFromOrganization(&state, *organization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *organizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan organizationSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := services.UpdateOrganization(ctx, r.client, buildUpdateOrganizationRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating organization settings", err.Error())
+		return
+	}
+	setOrganizationSettingsResourceFromOrganization(&plan, *organization)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state. An organization always has settings, so they are left as is.
+func (r *organizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Removing settings of organization %s from state; the settings are kept", state.OrganizationUUID.ValueString()))
+}
+
+func (r *organizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractOrganizationSettingsResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	organizationUUID := extracted[0]
+
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUUID)...)
+}
+
+// buildUpdateOrganizationRequest only sends the settings that are configured, so unset ones keep their current value.
+func buildUpdateOrganizationRequest(plan organizationSettingsResourceModel) apiv1.UpdateMyOrganizationV1Request {
+	request := apiv1.UpdateMyOrganizationV1Request{}
+	if name := plan.Name.ValueString(); name != "" {
+		request.Name = &name
+	}
+	if !plan.DefaultProjectUUID.IsNull() && !plan.DefaultProjectUUID.IsUnknown() {
+		defaultProjectUUID := plan.DefaultProjectUUID.ValueString()
+		request.DefaultProjectUUID = &defaultProjectUUID
+	}
+	if !plan.ColorPaletteUUID.IsNull() && !plan.ColorPaletteUUID.IsUnknown() {
+		colorPaletteUUID := plan.ColorPaletteUUID.ValueString()
+		request.ColorPaletteUUID = &colorPaletteUUID
+	}
+	return request
+}
+
+func setOrganizationSettingsResourceFromOrganization(model *organizationSettingsResourceModel, organization apiv1.GetMyOrganizationV1Results) {
+	model.OrganizationUUID = types.StringValue(organization.OrganizationUUID)
+	model.Name = types.StringValue(organization.Name)
+	model.DefaultProjectUUID = nonEmptyStringValue(organization.DefaultProjectUUID)
+	model.ColorPaletteUUID = nonEmptyStringValue(organization.ColorPaletteUUID)
+}
+
+func getOrganizationSettingsResourceID(organizationUUID string) string {
+	return fmt.Sprintf("organizations/%s/settings", organizationUUID)
+}
+
+func extractOrganizationSettingsResourceID(input string) ([]string, error) {
+	pattern := `^organizations/([^/]+)/settings$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0]}, nil
+}
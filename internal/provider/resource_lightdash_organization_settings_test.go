@@ -0,0 +1,83 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestExtractOrganizationSettingsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractOrganizationSettingsResourceID("organizations/org-uuid/settings")
+	if err != nil {
+		t.Fatalf("extractOrganizationSettingsResourceID: %v", err)
+	}
+	if got[0] != "org-uuid" {
+		t.Errorf("organization UUID: got %q", got[0])
+	}
+
+	if _, err := extractOrganizationSettingsResourceID("organizations/org-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetOrganizationSettingsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getOrganizationSettingsResourceID("org-uuid")
+	want := "organizations/org-uuid/settings"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestBuildUpdateOrganizationRequest(t *testing.T) {
+	t.Parallel()
+
+	request := buildUpdateOrganizationRequest(organizationSettingsResourceModel{
+		Name:               types.StringValue("Acme"),
+		DefaultProjectUUID: types.StringValue("project-uuid"),
+		ColorPaletteUUID:   types.StringUnknown(),
+	})
+	if request.Name == nil || *request.Name != "Acme" {
+		t.Errorf("unexpected name: %v", request.Name)
+	}
+	if request.DefaultProjectUUID == nil || *request.DefaultProjectUUID != "project-uuid" {
+		t.Errorf("unexpected default project UUID: %v", request.DefaultProjectUUID)
+	}
+	if request.ColorPaletteUUID != nil {
+		t.Errorf("expected unknown color palette to be left unchanged, got %q", *request.ColorPaletteUUID)
+	}
+}
+
+func TestSetOrganizationSettingsResourceFromOrganization(t *testing.T) {
+	t.Parallel()
+
+	var model organizationSettingsResourceModel
+	setOrganizationSettingsResourceFromOrganization(&model, apiv1.GetMyOrganizationV1Results{
+		OrganizationUUID: "org-uuid",
+		Name:             "Acme",
+	})
+	if model.Name.ValueString() != "Acme" {
+		t.Errorf("unexpected name: %q", model.Name.ValueString())
+	}
+	if !model.DefaultProjectUUID.IsNull() || !model.ColorPaletteUUID.IsNull() {
+		t.Errorf("expected unset settings to be null, got %q and %q", model.DefaultProjectUUID.ValueString(), model.ColorPaletteUUID.ValueString())
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &personalAccessTokenResource{}
+	_ resource.ResourceWithConfigure      = &personalAccessTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &personalAccessTokenResource{}
+	_ resource.ResourceWithValidateConfig = &personalAccessTokenResource{}
+)
+
+func NewPersonalAccessTokenResource() resource.Resource {
+	return &personalAccessTokenResource{}
+}
+
+type personalAccessTokenResource struct {
+	client *api.Client
+}
+
+type personalAccessTokenResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	TokenUUID      types.String `tfsdk:"token_uuid"`
+	Description    types.String `tfsdk:"description"`
+	ExpirationDays types.Int64  `tfsdk:"expiration_days"`
+	RotationDays   types.Int64  `tfsdk:"rotation_days"`
+	Token          types.String `tfsdk:"token"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	RotatedAt      types.String `tfsdk:"rotated_at"`
+}
+
+func (r *personalAccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_personal_access_token"
+}
+
+func (r *personalAccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_personal_access_token.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash personal access token of the authenticated user",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `users/me/personal_access_tokens/<token_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the personal access token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the token, shown in the Lightdash settings.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the token is valid after it is created or rotated. When unset, the token never expires.",
+				Optional:            true,
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which the token is rotated on the next apply. Must be less than `expiration_days`.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The value of the token. Lightdash only returns it when the token is created or rotated.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token expires, if any.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token was last rotated, if ever.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *personalAccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *personalAccessTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTokenRotationConfig(config.ExpirationDays, config.RotationDays)...)
+}
+
+// ModifyPlan plans a rotation when the token is due for one or when its expiration changes,
+// since rotating is the only way to change the expiry of a token.
+func (r *personalAccessTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ExpirationDays.IsUnknown() || plan.RotationDays.IsUnknown() {
+		return
+	}
+
+	tokenName := fmt.Sprintf("personal access token %s", state.TokenUUID.ValueString())
+	planTokenRotation(ctx, resp, tokenName, state.ExpirationDays, plan.ExpirationDays, plan.RotationDays, state.CreatedAt, state.RotatedAt, time.Now())
+}
+
+func (r *personalAccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewPersonalAccessTokenService(r.client)
+	token, err := service.CreateToken(ctx, plan.Description.ValueString(), tokenExpiration(plan.ExpirationDays))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating personal access token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created personal access token %s", token.UUID))
+
+	plan.ID = types.StringValue(getPersonalAccessTokenResourceID(token.UUID))
+	plan.Token = types.StringValue(token.Token)
+	setPersonalAccessTokenResourceFromToken(&plan, token.AccessTokenV1)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *personalAccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewPersonalAccessTokenService(r.client)
+	token, err := service.GetToken(ctx, state.TokenUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrPersonalAccessTokenNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Personal access token %s not found, removing from state", state.TokenUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading personal access token", err.Error())
+		return
+	}
+	setPersonalAccessTokenResourceFromToken(&state, *token)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *personalAccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is only unknown when ModifyPlan planned a rotation.
+	if plan.Token.IsUnknown() {
+		service := services.NewPersonalAccessTokenService(r.client)
+		token, err := service.RotateToken(ctx, state.TokenUUID.ValueString(), tokenExpiration(plan.ExpirationDays))
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating personal access token", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated personal access token %s", state.TokenUUID.ValueString()))
+
+		plan.Token = types.StringValue(token.Token)
+		setPersonalAccessTokenResourceFromToken(&plan, token.AccessTokenV1)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *personalAccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state personalAccessTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewPersonalAccessTokenService(r.client)
+	if err := service.DeleteToken(ctx, state.TokenUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting personal access token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted personal access token %s", state.TokenUUID.ValueString()))
+}
+
+// validateTokenRotationConfig checks that a token is rotated before it expires.
+func validateTokenRotationConfig(expirationDays, rotationDays types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if expirationDays.IsUnknown() || rotationDays.IsUnknown() {
+		return diags
+	}
+
+	if !expirationDays.IsNull() && expirationDays.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("expiration_days"), "Invalid expiration", "expiration_days must be at least 1")
+	}
+	if rotationDays.IsNull() {
+		return diags
+	}
+	if rotationDays.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("rotation_days"), "Invalid rotation", "rotation_days must be at least 1")
+	}
+	if expirationDays.IsNull() || rotationDays.ValueInt64() >= expirationDays.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("rotation_days"),
+			"Invalid rotation",
+			"rotation_days requires expiration_days and must be less than it, so that the token is rotated before it expires",
+		)
+	}
+	return diags
+}
+
+// tokenRotationPlanned reports whether a token has to be rotated, either because its expiration changes
+// or because it is due for a rotation.
+func tokenRotationPlanned(stateExpirationDays, planExpirationDays, rotationDays types.Int64, createdAt, rotatedAt types.String, now time.Time) (bool, error) {
+	if !planExpirationDays.Equal(stateExpirationDays) {
+		return true, nil
+	}
+	if rotationDays.IsNull() {
+		return false, nil
+	}
+	lastRotatedAt := createdAt.ValueString()
+	if !rotatedAt.IsNull() {
+		lastRotatedAt = rotatedAt.ValueString()
+	}
+	return tokenRotationDue(lastRotatedAt, rotationDays.ValueInt64(), now)
+}
+
+// planTokenRotation plans the replacement or rotation of a token on update.
+// A token that is changed to never expire is replaced, since it cannot be rotated into one.
+// A token that has to be rotated gets an unknown token, expires_at and rotated_at.
+// It returns true when a replacement or rotation is planned, or when the rotation could not be checked.
+func planTokenRotation(ctx context.Context, resp *resource.ModifyPlanResponse, tokenName string, stateExpirationDays, planExpirationDays, rotationDays types.Int64, createdAt, rotatedAt types.String, now time.Time) bool {
+	if planExpirationDays.IsNull() && !stateExpirationDays.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expiration_days"))
+		return true
+	}
+
+	rotate, err := tokenRotationPlanned(stateExpirationDays, planExpirationDays, rotationDays, createdAt, rotatedAt, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking token rotation", err.Error())
+		return true
+	}
+	if !rotate {
+		return false
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Planning rotation of %s", tokenName))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rotated_at"), types.StringUnknown())...)
+	return true
+}
+
+// tokenRotationDue reports whether rotationDays have passed since the token was last created or rotated.
+func tokenRotationDue(lastRotatedAt string, rotationDays int64, now time.Time) (bool, error) {
+	rotatedAt, err := time.Parse(time.RFC3339, lastRotatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse token timestamp %q: %w", lastRotatedAt, err)
+	}
+	return !now.Before(rotatedAt.Add(time.Duration(rotationDays) * 24 * time.Hour)), nil
+}
+
+// tokenExpiration returns the lifetime of a token, or zero for a token that never expires.
+func tokenExpiration(expirationDays types.Int64) time.Duration {
+	if expirationDays.IsNull() || expirationDays.IsUnknown() {
+		return 0
+	}
+	return time.Duration(expirationDays.ValueInt64()) * 24 * time.Hour
+}
+
+func setPersonalAccessTokenResourceFromToken(model *personalAccessTokenResourceModel, token apiv1.AccessTokenV1) {
+	model.TokenUUID = types.StringValue(token.UUID)
+	model.ExpiresAt = nonEmptyStringValue(token.ExpiresAt)
+	model.CreatedAt = types.StringValue(token.CreatedAt)
+	model.RotatedAt = nonEmptyStringValue(token.RotatedAt)
+}
+
+func getPersonalAccessTokenResourceID(tokenUUID string) string {
+	return fmt.Sprintf("users/me/personal_access_tokens/%s", tokenUUID)
+}
@@ -0,0 +1,207 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGetPersonalAccessTokenResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getPersonalAccessTokenResourceID("token-uuid")
+	want := "users/me/personal_access_tokens/token-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestTokenRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		lastRotatedAt string
+		rotationDays  int64
+		want          bool
+		wantErr       bool
+	}{
+		{name: "not yet due", lastRotatedAt: "2024-03-02T00:00:00.000Z", rotationDays: 30, want: false},
+		{name: "due", lastRotatedAt: "2024-03-01T00:00:00.000Z", rotationDays: 30, want: true},
+		{name: "overdue", lastRotatedAt: "2024-01-01T00:00:00Z", rotationDays: 30, want: true},
+		{name: "invalid timestamp", lastRotatedAt: "yesterday", rotationDays: 30, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tokenRotationDue(tc.lastRotatedAt, tc.rotationDays, now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateTokenRotationConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		expirationDays types.Int64
+		rotationDays   types.Int64
+		wantErrors     int
+	}{
+		{name: "never expires", expirationDays: types.Int64Null(), rotationDays: types.Int64Null()},
+		{name: "rotated before expiry", expirationDays: types.Int64Value(90), rotationDays: types.Int64Value(60)},
+		{name: "unknown", expirationDays: types.Int64Unknown(), rotationDays: types.Int64Value(60)},
+		{name: "rotation without expiration", expirationDays: types.Int64Null(), rotationDays: types.Int64Value(30), wantErrors: 1},
+		{name: "rotation after expiry", expirationDays: types.Int64Value(30), rotationDays: types.Int64Value(30), wantErrors: 1},
+		{name: "non-positive values", expirationDays: types.Int64Value(0), rotationDays: types.Int64Value(-1), wantErrors: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateTokenRotationConfig(tc.expirationDays, tc.rotationDays)
+			if diags.ErrorsCount() != tc.wantErrors {
+				t.Errorf("got %d errors, want %d: %v", diags.ErrorsCount(), tc.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestTokenRotationPlanned(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	createdAt := types.StringValue("2024-01-01T00:00:00.000Z")
+
+	got, err := tokenRotationPlanned(types.Int64Value(90), types.Int64Value(30), types.Int64Null(), createdAt, types.StringNull(), now)
+	if err != nil || !got {
+		t.Errorf("expected a rotation when the expiration changes, got %v, %v", got, err)
+	}
+
+	got, err = tokenRotationPlanned(types.Int64Value(90), types.Int64Value(90), types.Int64Null(), createdAt, types.StringNull(), now)
+	if err != nil || got {
+		t.Errorf("expected no rotation without rotation_days, got %v, %v", got, err)
+	}
+
+	// The last rotation takes precedence over the creation.
+	got, err = tokenRotationPlanned(types.Int64Value(90), types.Int64Value(90), types.Int64Value(30), createdAt, types.StringValue("2024-03-15T00:00:00.000Z"), now)
+	if err != nil || got {
+		t.Errorf("expected no rotation after a recent rotation, got %v, %v", got, err)
+	}
+
+	got, err = tokenRotationPlanned(types.Int64Value(90), types.Int64Value(90), types.Int64Value(30), createdAt, types.StringNull(), now)
+	if err != nil || !got {
+		t.Errorf("expected a rotation when due, got %v, %v", got, err)
+	}
+}
+
+func TestPlanTokenRotation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	createdAt := types.StringValue("2024-01-01T00:00:00.000Z")
+
+	resp := &fwresource.ModifyPlanResponse{}
+	if !planTokenRotation(context.Background(), resp, "token", types.Int64Value(90), types.Int64Null(), types.Int64Null(), createdAt, types.StringNull(), now) {
+		t.Error("expected a planned replacement when the token stops expiring")
+	}
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("expiration_days")) {
+		t.Errorf("expected expiration_days to require a replacement, got %v", resp.RequiresReplace)
+	}
+
+	resp = &fwresource.ModifyPlanResponse{}
+	if planTokenRotation(context.Background(), resp, "token", types.Int64Value(90), types.Int64Value(90), types.Int64Value(30), createdAt, types.StringValue("2024-03-15T00:00:00.000Z"), now) {
+		t.Error("expected no rotation after a recent rotation")
+	}
+	if len(resp.RequiresReplace) != 0 || resp.Diagnostics.HasError() {
+		t.Errorf("expected an unchanged plan, got %v, %v", resp.RequiresReplace, resp.Diagnostics)
+	}
+
+	resp = &fwresource.ModifyPlanResponse{}
+	if !planTokenRotation(context.Background(), resp, "token", types.Int64Value(90), types.Int64Value(90), types.Int64Value(30), types.StringValue("invalid"), types.StringNull(), now) {
+		t.Error("expected the check to stop on an invalid timestamp")
+	}
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for an invalid timestamp")
+	}
+}
+
+func TestTokenExpiration(t *testing.T) {
+	t.Parallel()
+
+	if got := tokenExpiration(types.Int64Null()); got != 0 {
+		t.Errorf("expected no expiration, got %v", got)
+	}
+	if got := tokenExpiration(types.Int64Value(2)); got != 48*time.Hour {
+		t.Errorf("got %v, want %v", got, 48*time.Hour)
+	}
+}
+
+func TestAccPersonalAccessTokenResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_personal_access_token")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_personal_access_token", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_personal_access_token", "lifecycle", "020_update_expiration.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_personal_access_token.test", "token_uuid"),
+					resource.TestCheckResourceAttrSet("lightdash_personal_access_token.test", "token"),
+					resource.TestCheckResourceAttrSet("lightdash_personal_access_token.test", "expires_at"),
+					resource.TestCheckNoResourceAttr("lightdash_personal_access_token.test", "rotated_at"),
+				),
+			},
+			// Changing the expiration rotates the token in place.
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_personal_access_token.test", "expiration_days", "14"),
+					resource.TestCheckResourceAttrSet("lightdash_personal_access_token.test", "rotated_at"),
+				),
+			},
+		},
+	})
+}
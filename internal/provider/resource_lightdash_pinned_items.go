@@ -0,0 +1,322 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &pinnedItemsResource{}
+	_ resource.ResourceWithConfigure      = &pinnedItemsResource{}
+	_ resource.ResourceWithImportState    = &pinnedItemsResource{}
+	_ resource.ResourceWithValidateConfig = &pinnedItemsResource{}
+)
+
+func NewPinnedItemsResource() resource.Resource {
+	return &pinnedItemsResource{}
+}
+
+type pinnedItemsResource struct {
+	client *api.Client
+}
+
+type pinnedItemsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectUUID    types.String `tfsdk:"project_uuid"`
+	PinnedListUUID types.String `tfsdk:"pinned_list_uuid"`
+	Items          types.List   `tfsdk:"items"`
+}
+
+type pinnedItemModel struct {
+	Type types.String `tfsdk:"type"`
+	UUID types.String `tfsdk:"uuid"`
+}
+
+var pinnedItemAttrTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"uuid": types.StringType,
+}
+
+func (r *pinnedItemsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pinned_items"
+}
+
+func (r *pinnedItemsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_pinned_items.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the ordered list of items pinned to a Lightdash project homepage",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/pinned_items`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project whose homepage pins are managed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pinned_list_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project's pinned list in Lightdash.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The pinned items in the order they appear on the homepage.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the pinned item. One of `space`, `chart` or `dashboard`.",
+							Required:            true,
+						},
+						"uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the space, chart or dashboard.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pinnedItemsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *pinnedItemsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config pinnedItemsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Items.IsNull() || config.Items.IsUnknown() {
+		return
+	}
+
+	var items []pinnedItemModel
+	resp.Diagnostics.Append(config.Items.ElementsAs(ctx, &items, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, err := range validatePinnedItems(items) {
+		resp.Diagnostics.AddAttributeError(path.Root("items"), "Invalid pinned items", err.Error())
+	}
+}
+
+func (r *pinnedItemsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan pinnedItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setPinnedItems(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(getPinnedItemsResourceID(plan.ProjectUUID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pinnedItemsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pinnedItemsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewPinnedItemsService(r.client)
+	pinnedListUUID, items, err := service.GetPinnedItems(ctx, state.ProjectUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading pinned items", err.Error())
+		return
+	}
+
+	state.PinnedListUUID = types.StringValue(pinnedListUUID)
+	itemsValue, diags := pinnedItemsValue(ctx, items)
+	resp.Diagnostics.Append(diags...)
+	state.Items = itemsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pinnedItemsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pinnedItemsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setPinnedItems(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unpins the items managed by the resource. Items pinned outside of Terraform since the last apply are kept.
+func (r *pinnedItemsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state pinnedItemsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := pinnedItemsFromList(ctx, state.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewPinnedItemsService(r.client)
+	if err := service.UnpinItems(ctx, state.ProjectUUID.ValueString(), items); err != nil {
+		resp.Diagnostics.AddError("Error unpinning items", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Unpinned %d items from project %s", len(items), state.ProjectUUID.ValueString()))
+}
+
+func (r *pinnedItemsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractPinnedItemsResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	projectUUID := extracted[0]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), projectUUID)...)
+}
+
+func (r *pinnedItemsResource) setPinnedItems(ctx context.Context, plan *pinnedItemsResourceModel, diags *diag.Diagnostics) {
+	items, itemDiags := pinnedItemsFromList(ctx, plan.Items)
+	diags.Append(itemDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	service := services.NewPinnedItemsService(r.client)
+	pinnedListUUID, err := service.SetPinnedItems(ctx, plan.ProjectUUID.ValueString(), items)
+	if err != nil {
+		diags.AddError("Error updating pinned items", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Pinned %d items in project %s", len(items), plan.ProjectUUID.ValueString()))
+
+	plan.PinnedListUUID = types.StringValue(pinnedListUUID)
+}
+
+func validatePinnedItems(items []pinnedItemModel) []error {
+	var errs []error
+	seen := map[models.PinnedItem]bool{}
+	for i, item := range items {
+		if item.Type.IsUnknown() || item.UUID.IsUnknown() {
+			continue
+		}
+		pinnedItem := models.PinnedItem{
+			Type: models.PinnedItemType(item.Type.ValueString()),
+			UUID: item.UUID.ValueString(),
+		}
+		if !pinnedItem.Type.IsValid() {
+			errs = append(errs, fmt.Errorf("items[%d]: type %q is invalid, must be `space`, `chart` or `dashboard`", i, item.Type.ValueString()))
+			continue
+		}
+		if seen[pinnedItem] {
+			errs = append(errs, fmt.Errorf("items[%d]: %s %q is listed more than once", i, pinnedItem.Type, pinnedItem.UUID))
+		}
+		seen[pinnedItem] = true
+	}
+	return errs
+}
+
+func pinnedItemsFromList(ctx context.Context, list types.List) ([]models.PinnedItem, diag.Diagnostics) {
+	var itemModels []pinnedItemModel
+	diags := list.ElementsAs(ctx, &itemModels, false)
+	items := make([]models.PinnedItem, 0, len(itemModels))
+	for _, item := range itemModels {
+		items = append(items, models.PinnedItem{
+			Type: models.PinnedItemType(item.Type.ValueString()),
+			UUID: item.UUID.ValueString(),
+		})
+	}
+	return items, diags
+}
+
+func pinnedItemsValue(ctx context.Context, items []models.PinnedItem) (types.List, diag.Diagnostics) {
+	itemModels := make([]pinnedItemModel, 0, len(items))
+	for _, item := range items {
+		itemModels = append(itemModels, pinnedItemModel{
+			Type: types.StringValue(item.Type.String()),
+			UUID: types.StringValue(item.UUID),
+		})
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pinnedItemAttrTypes}, itemModels)
+}
+
+func getPinnedItemsResourceID(projectUUID string) string {
+	return fmt.Sprintf("projects/%s/pinned_items", projectUUID)
+}
+
+func extractPinnedItemsResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/pinned_items$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0]}, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestExtractPinnedItemsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractPinnedItemsResourceID("projects/project-uuid/pinned_items")
+	if err != nil {
+		t.Fatalf("extractPinnedItemsResourceID: %v", err)
+	}
+	if got[0] != "project-uuid" {
+		t.Errorf("project UUID: got %q", got[0])
+	}
+
+	if _, err := extractPinnedItemsResourceID("project-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetPinnedItemsResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getPinnedItemsResourceID("project-uuid")
+	want := "projects/project-uuid/pinned_items"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidatePinnedItems(t *testing.T) {
+	t.Parallel()
+
+	valid := []pinnedItemModel{
+		{Type: types.StringValue("space"), UUID: types.StringValue("space-uuid")},
+		{Type: types.StringValue("dashboard"), UUID: types.StringValue("dashboard-uuid")},
+		{Type: types.StringValue("chart"), UUID: types.StringUnknown()},
+	}
+	if errs := validatePinnedItems(valid); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	invalid := []pinnedItemModel{
+		{Type: types.StringValue("sql_chart"), UUID: types.StringValue("chart-uuid")},
+		{Type: types.StringValue("space"), UUID: types.StringValue("space-uuid")},
+		{Type: types.StringValue("space"), UUID: types.StringValue("space-uuid")},
+	}
+	if errs := validatePinnedItems(invalid); len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %v", errs)
+	}
+}
+
+func TestPinnedItemsValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	items := []models.PinnedItem{
+		{Type: models.PINNED_ITEM_DASHBOARD, UUID: "dashboard-uuid"},
+		{Type: models.PINNED_ITEM_SPACE, UUID: "space-uuid"},
+	}
+	list, diags := pinnedItemsValue(ctx, items)
+	if diags.HasError() {
+		t.Fatalf("pinnedItemsValue: %v", diags)
+	}
+	got, diags := pinnedItemsFromList(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("pinnedItemsFromList: %v", diags)
+	}
+	if len(got) != 2 || got[0] != items[0] || got[1] != items[1] {
+		t.Errorf("expected items to round trip in order, got %v", got)
+	}
+}
+
+func TestAccPinnedItemsResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_pinned_items")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_pinned_items", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_pinned_items", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_pinned_items.test", "items.#", "1"),
+					resource.TestCheckResourceAttr("lightdash_pinned_items.test", "items.0.type", "space"),
+					resource.TestCheckResourceAttrSet("lightdash_pinned_items.test", "pinned_list_uuid"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_pinned_items.test", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("lightdash_pinned_items.test", "items.0.uuid", "lightdash_space.pinned_items_second", "space_uuid"),
+				),
+			},
+			{
+				ResourceName:      "lightdash_pinned_items.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
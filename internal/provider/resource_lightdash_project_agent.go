@@ -216,7 +216,7 @@ func (r *projectAgentResource) Schema(ctx context.Context, req resource.SchemaRe
 							Required:            true,
 						},
 						"channel_id": schema.StringAttribute{
-							MarkdownDescription: "The channel ID for the integration.",
+							MarkdownDescription: "The channel ID for the integration. Slack channel IDs can be looked up by name with the `lightdash_slack_channels` data source.",
 							Optional:            true,
 						},
 					},
@@ -0,0 +1,381 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &scimTokenResource{}
+	_ resource.ResourceWithConfigure      = &scimTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &scimTokenResource{}
+	_ resource.ResourceWithValidateConfig = &scimTokenResource{}
+)
+
+// The number of days before expiry from which plans warn about the SCIM token.
+const defaultScimTokenExpiryWarningDays = 14
+
+func NewScimTokenResource() resource.Resource {
+	return &scimTokenResource{}
+}
+
+type scimTokenResource struct {
+	client *api.Client
+}
+
+type scimTokenResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	OrganizationUUID  types.String `tfsdk:"organization_uuid"`
+	TokenUUID         types.String `tfsdk:"token_uuid"`
+	Description       types.String `tfsdk:"description"`
+	ExpirationDays    types.Int64  `tfsdk:"expiration_days"`
+	RotationDays      types.Int64  `tfsdk:"rotation_days"`
+	ExpiryWarningDays types.Int64  `tfsdk:"expiry_warning_days"`
+	Token             types.String `tfsdk:"token"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	DaysUntilExpiry   types.Int64  `tfsdk:"days_until_expiry"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	RotatedAt         types.String `tfsdk:"rotated_at"`
+	LastUsedAt        types.String `tfsdk:"last_used_at"`
+}
+
+func (r *scimTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_token"
+}
+
+func (r *scimTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_scim_token.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash SCIM access token for identity provider provisioning",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/scim_tokens/<token_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization the token provisions.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the SCIM token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the token, shown in the Lightdash settings.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the token is valid after it is created or rotated. When unset, the token never expires.",
+				Optional:            true,
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which the token is rotated on the next apply. Must be less than `expiration_days`.",
+				Optional:            true,
+			},
+			"expiry_warning_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Plans show a warning when the token expires within this number of days. Defaults to `%d`.", defaultScimTokenExpiryWarningDays),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultScimTokenExpiryWarningDays),
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The value of the token. Lightdash only returns it when the token is created or rotated.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token expires, if any.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"days_until_expiry": schema.Int64Attribute{
+				MarkdownDescription: "The number of whole days left until the token expires, as of the last refresh. It is negative once the token has expired, and null when it never expires.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token was last rotated, if ever.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the identity provider last used the token, if ever.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *scimTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *scimTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config scimTokenResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTokenRotationConfig(config.ExpirationDays, config.RotationDays)...)
+	if !config.ExpiryWarningDays.IsNull() && !config.ExpiryWarningDays.IsUnknown() && config.ExpiryWarningDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("expiry_warning_days"), "Invalid expiry warning", "expiry_warning_days must not be negative")
+	}
+}
+
+// ModifyPlan plans a rotation when the token is due for one or when its expiration changes,
+// and otherwise warns when the token is about to expire.
+func (r *scimTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan scimTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ExpirationDays.IsUnknown() || plan.RotationDays.IsUnknown() {
+		return
+	}
+
+	now := time.Now()
+	tokenName := fmt.Sprintf("SCIM token %s", state.TokenUUID.ValueString())
+	if planTokenRotation(ctx, resp, tokenName, state.ExpirationDays, plan.ExpirationDays, plan.RotationDays, state.CreatedAt, state.RotatedAt, now) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("days_until_expiry"), types.Int64Unknown())...)
+		}
+		return
+	}
+
+	daysUntilExpiry, err := scimTokenDaysUntilExpiry(state.ExpiresAt, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking token expiry", err.Error())
+		return
+	}
+	if daysUntilExpiry.IsNull() || plan.ExpiryWarningDays.IsUnknown() || daysUntilExpiry.ValueInt64() > plan.ExpiryWarningDays.ValueInt64() {
+		return
+	}
+	if daysUntilExpiry.ValueInt64() < 0 {
+		resp.Diagnostics.AddWarning(
+			"SCIM token has expired",
+			fmt.Sprintf("The SCIM token %q expired at %s. Change expiration_days or set rotation_days to rotate it.", state.Description.ValueString(), state.ExpiresAt.ValueString()),
+		)
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"SCIM token expires soon",
+		fmt.Sprintf("The SCIM token %q expires in %d days, at %s. Change expiration_days or set rotation_days to rotate it.", state.Description.ValueString(), daysUntilExpiry.ValueInt64(), state.ExpiresAt.ValueString()),
+	)
+}
+
+func (r *scimTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan scimTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationUUID := plan.OrganizationUUID.ValueString()
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewScimTokenService(r.client)
+	token, err := service.CreateToken(ctx, plan.Description.ValueString(), tokenExpiration(plan.ExpirationDays))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SCIM token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created SCIM token %s", token.UUID))
+
+	plan.ID = types.StringValue(getScimTokenResourceID(organizationUUID, token.UUID))
+	plan.Token = types.StringValue(token.Token)
+	if err := setScimTokenResourceFromToken(&plan, token.AccessTokenV1, time.Now()); err != nil {
+		resp.Diagnostics.AddError("Error reading SCIM token", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scimTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state scimTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewScimTokenService(r.client)
+	token, err := service.GetToken(ctx, state.TokenUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrScimTokenNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("SCIM token %s not found, removing from state", state.TokenUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading SCIM token", err.Error())
+		return
+	}
+	if err := setScimTokenResourceFromToken(&state, *token, time.Now()); err != nil {
+		resp.Diagnostics.AddError("Error reading SCIM token", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *scimTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state scimTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is only unknown when ModifyPlan planned a rotation.
+	if plan.Token.IsUnknown() {
+		service := services.NewScimTokenService(r.client)
+		token, err := service.RotateToken(ctx, state.TokenUUID.ValueString(), tokenExpiration(plan.ExpirationDays))
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating SCIM token", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated SCIM token %s", state.TokenUUID.ValueString()))
+
+		plan.Token = types.StringValue(token.Token)
+		if err := setScimTokenResourceFromToken(&plan, token.AccessTokenV1, time.Now()); err != nil {
+			resp.Diagnostics.AddError("Error reading SCIM token", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *scimTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state scimTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewScimTokenService(r.client)
+	if err := service.DeleteToken(ctx, state.TokenUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting SCIM token", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted SCIM token %s", state.TokenUUID.ValueString()))
+}
+
+// scimTokenDaysUntilExpiry returns the number of whole days left until expiresAt, or null for a token that never expires.
+func scimTokenDaysUntilExpiry(expiresAt types.String, now time.Time) (types.Int64, error) {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return types.Int64Null(), nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return types.Int64Null(), fmt.Errorf("failed to parse token timestamp %q: %w", expiresAt.ValueString(), err)
+	}
+	return types.Int64Value(int64(math.Floor(expiry.Sub(now).Hours() / 24))), nil
+}
+
+func setScimTokenResourceFromToken(model *scimTokenResourceModel, token apiv1.AccessTokenV1, now time.Time) error {
+	model.TokenUUID = types.StringValue(token.UUID)
+	model.ExpiresAt = nonEmptyStringValue(token.ExpiresAt)
+	model.CreatedAt = types.StringValue(token.CreatedAt)
+	model.RotatedAt = nonEmptyStringValue(token.RotatedAt)
+	model.LastUsedAt = nonEmptyStringValue(token.LastUsedAt)
+
+	daysUntilExpiry, err := scimTokenDaysUntilExpiry(model.ExpiresAt, now)
+	if err != nil {
+		return err
+	}
+	model.DaysUntilExpiry = daysUntilExpiry
+	return nil
+}
+
+func getScimTokenResourceID(organizationUUID string, tokenUUID string) string {
+	return fmt.Sprintf("organizations/%s/scim_tokens/%s", organizationUUID, tokenUUID)
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestGetScimTokenResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getScimTokenResourceID("org-uuid", "token-uuid")
+	want := "organizations/org-uuid/scim_tokens/token-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestScimTokenDaysUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name      string
+		expiresAt types.String
+		want      types.Int64
+		wantErr   bool
+	}{
+		{name: "never expires", expiresAt: types.StringNull(), want: types.Int64Null()},
+		{name: "expires in ten days", expiresAt: types.StringValue("2024-03-11T12:00:00.000Z"), want: types.Int64Value(10)},
+		{name: "partial days are dropped", expiresAt: types.StringValue("2024-03-02T00:00:00.000Z"), want: types.Int64Value(0)},
+		{name: "expired", expiresAt: types.StringValue("2024-02-29T12:00:00Z"), want: types.Int64Value(-1)},
+		{name: "invalid timestamp", expiresAt: types.StringValue("soon"), want: types.Int64Null(), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := scimTokenDaysUntilExpiry(tc.expiresAt, now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetScimTokenResourceFromToken(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := "2024-03-31T00:00:00.000Z"
+	model := scimTokenResourceModel{Token: types.StringValue("scim_secret")}
+	err := setScimTokenResourceFromToken(&model, apiv1.AccessTokenV1{
+		UUID:      "token-uuid",
+		ExpiresAt: &expiresAt,
+		CreatedAt: "2024-01-01T00:00:00.000Z",
+	}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if model.TokenUUID.ValueString() != "token-uuid" || model.ExpiresAt.ValueString() != expiresAt {
+		t.Errorf("unexpected model: %+v", model)
+	}
+	if model.DaysUntilExpiry.ValueInt64() != 30 {
+		t.Errorf("got days_until_expiry %v, want 30", model.DaysUntilExpiry)
+	}
+	if !model.RotatedAt.IsNull() || !model.LastUsedAt.IsNull() {
+		t.Errorf("expected rotated_at and last_used_at to be null, got %v and %v", model.RotatedAt, model.LastUsedAt)
+	}
+	if model.Token.ValueString() != "scim_secret" {
+		t.Errorf("expected the token to be kept, got %v", model.Token)
+	}
+}
+
+func TestAccScimTokenResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_scim_token")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_scim_token", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_scim_token", "lifecycle", "020_update_expiration.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_scim_token.test", "token_uuid"),
+					resource.TestCheckResourceAttrSet("lightdash_scim_token.test", "token"),
+					resource.TestCheckResourceAttr("lightdash_scim_token.test", "days_until_expiry", "29"),
+				),
+			},
+			// Changing the expiration rotates the token in place.
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_scim_token.test", "days_until_expiry", "59"),
+					resource.TestCheckResourceAttrSet("lightdash_scim_token.test", "rotated_at"),
+				),
+			},
+		},
+	})
+}
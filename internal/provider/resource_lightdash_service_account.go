@@ -0,0 +1,340 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &serviceAccountResource{}
+	_ resource.ResourceWithConfigure      = &serviceAccountResource{}
+	_ resource.ResourceWithModifyPlan     = &serviceAccountResource{}
+	_ resource.ResourceWithValidateConfig = &serviceAccountResource{}
+)
+
+func NewServiceAccountResource() resource.Resource {
+	return &serviceAccountResource{}
+}
+
+type serviceAccountResource struct {
+	client *api.Client
+}
+
+type serviceAccountResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	OrganizationUUID   types.String `tfsdk:"organization_uuid"`
+	ServiceAccountUUID types.String `tfsdk:"service_account_uuid"`
+	Description        types.String `tfsdk:"description"`
+	Scopes             types.Set    `tfsdk:"scopes"`
+	ExpirationDays     types.Int64  `tfsdk:"expiration_days"`
+	RotationDays       types.Int64  `tfsdk:"rotation_days"`
+	Token              types.String `tfsdk:"token"`
+	ExpiresAt          types.String `tfsdk:"expires_at"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	RotatedAt          types.String `tfsdk:"rotated_at"`
+}
+
+func (r *serviceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *serviceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_service_account.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash service account and its token",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/service_accounts/<service_account_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization the service account belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_account_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the service account.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the service account, shown in the Lightdash settings.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "The scopes granted to the token: `org:admin`, `org:edit`, `org:read` or `scim:manage`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the token is valid after it is created or rotated. When unset, the token never expires.",
+				Optional:            true,
+			},
+			"rotation_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days after which the token is rotated on the next apply. Must be less than `expiration_days`.",
+				Optional:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token of the service account. Lightdash only returns it when the service account is created or its token is rotated.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token expires, if any.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the service account was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the token was last rotated, if ever.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *serviceAccountResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config serviceAccountResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTokenRotationConfig(config.ExpirationDays, config.RotationDays)...)
+	if config.Scopes.IsUnknown() {
+		return
+	}
+	var scopes []types.String
+	resp.Diagnostics.Append(config.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if len(scopes) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid scopes", "At least one scope is required")
+	}
+	for _, scope := range scopes {
+		if scope.IsUnknown() || scope.IsNull() {
+			continue
+		}
+		if !models.ServiceAccountScope(scope.ValueString()).IsValid() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scopes"),
+				"Invalid scope",
+				fmt.Sprintf("%q is not a valid scope. Valid scopes are org:admin, org:edit, org:read and scim:manage", scope.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan plans a rotation when the token is due for one or when its expiration changes,
+// since rotating is the only way to change the expiry of a token.
+func (r *serviceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan serviceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ExpirationDays.IsUnknown() || plan.RotationDays.IsUnknown() {
+		return
+	}
+
+	tokenName := fmt.Sprintf("service account %s", state.ServiceAccountUUID.ValueString())
+	planTokenRotation(ctx, resp, tokenName, state.ExpirationDays, plan.ExpirationDays, plan.RotationDays, state.CreatedAt, state.RotatedAt, time.Now())
+}
+
+func (r *serviceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationUUID := plan.OrganizationUUID.ValueString()
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var scopes []string
+	resp.Diagnostics.Append(plan.Scopes.ElementsAs(ctx, &scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewServiceAccountService(r.client)
+	serviceAccount, err := service.CreateServiceAccount(ctx, plan.Description.ValueString(), scopes, tokenExpiration(plan.ExpirationDays))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating service account", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created service account %s", serviceAccount.UUID))
+
+	plan.ID = types.StringValue(getServiceAccountResourceID(organizationUUID, serviceAccount.UUID))
+	plan.Token = types.StringValue(serviceAccount.Token)
+	resp.Diagnostics.Append(setServiceAccountResourceFromServiceAccount(ctx, &plan, serviceAccount.ServiceAccountV1)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewServiceAccountService(r.client)
+	serviceAccount, err := service.GetServiceAccount(ctx, state.ServiceAccountUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrServiceAccountNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Service account %s not found, removing from state", state.ServiceAccountUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading service account", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(setServiceAccountResourceFromServiceAccount(ctx, &state, *serviceAccount)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serviceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is only unknown when ModifyPlan planned a rotation.
+	if plan.Token.IsUnknown() {
+		service := services.NewServiceAccountService(r.client)
+		serviceAccount, err := service.RotateServiceAccount(ctx, state.ServiceAccountUUID.ValueString(), tokenExpiration(plan.ExpirationDays))
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating service account token", err.Error())
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rotated the token of service account %s", state.ServiceAccountUUID.ValueString()))
+
+		plan.Token = types.StringValue(serviceAccount.Token)
+		resp.Diagnostics.Append(setServiceAccountResourceFromServiceAccount(ctx, &plan, serviceAccount.ServiceAccountV1)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *serviceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewServiceAccountService(r.client)
+	if err := service.DeleteServiceAccount(ctx, state.ServiceAccountUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting service account", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted service account %s", state.ServiceAccountUUID.ValueString()))
+}
+
+func setServiceAccountResourceFromServiceAccount(ctx context.Context, model *serviceAccountResourceModel, serviceAccount apiv1.ServiceAccountV1) diag.Diagnostics {
+	scopes := append([]string{}, serviceAccount.Scopes...)
+	sort.Strings(scopes)
+	scopeSet, diags := types.SetValueFrom(ctx, types.StringType, scopes)
+
+	model.ServiceAccountUUID = types.StringValue(serviceAccount.UUID)
+	model.Description = types.StringValue(serviceAccount.Description)
+	model.Scopes = scopeSet
+	model.ExpiresAt = nonEmptyStringValue(serviceAccount.ExpiresAt)
+	model.CreatedAt = types.StringValue(serviceAccount.CreatedAt)
+	model.RotatedAt = nonEmptyStringValue(serviceAccount.RotatedAt)
+	return diags
+}
+
+func getServiceAccountResourceID(organizationUUID string, serviceAccountUUID string) string {
+	return fmt.Sprintf("organizations/%s/service_accounts/%s", organizationUUID, serviceAccountUUID)
+}
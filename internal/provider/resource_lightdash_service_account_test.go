@@ -0,0 +1,111 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestGetServiceAccountResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getServiceAccountResourceID("org-uuid", "service-account-uuid")
+	want := "organizations/org-uuid/service_accounts/service-account-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSetServiceAccountResourceFromServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	rotatedAt := "2024-02-01T00:00:00.000Z"
+	model := serviceAccountResourceModel{Token: types.StringValue("ldsvc_secret")}
+	diags := setServiceAccountResourceFromServiceAccount(context.Background(), &model, apiv1.ServiceAccountV1{
+		UUID:        "service-account-uuid",
+		Description: "dbt CI",
+		Scopes:      []string{"org:read", "org:edit"},
+		CreatedAt:   "2024-01-01T00:00:00.000Z",
+		RotatedAt:   &rotatedAt,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if model.ServiceAccountUUID.ValueString() != "service-account-uuid" || model.Description.ValueString() != "dbt CI" {
+		t.Errorf("unexpected model: %+v", model)
+	}
+	if len(model.Scopes.Elements()) != 2 {
+		t.Errorf("expected 2 scopes, got %v", model.Scopes)
+	}
+	if !model.ExpiresAt.IsNull() {
+		t.Errorf("expected expires_at to be null, got %v", model.ExpiresAt)
+	}
+	if model.RotatedAt.ValueString() != rotatedAt {
+		t.Errorf("got rotated_at %v, want %q", model.RotatedAt, rotatedAt)
+	}
+	// The token is only returned on creation and rotation, so it is kept as is.
+	if model.Token.ValueString() != "ldsvc_secret" {
+		t.Errorf("expected the token to be kept, got %v", model.Token)
+	}
+}
+
+func TestAccServiceAccountResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_service_account")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_service_account", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_service_account", "lifecycle", "020_update_expiration.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_service_account.test", "service_account_uuid"),
+					resource.TestCheckResourceAttrSet("lightdash_service_account.test", "token"),
+					resource.TestCheckResourceAttr("lightdash_service_account.test", "scopes.#", "1"),
+				),
+			},
+			// Changing the expiration rotates the token in place.
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_service_account.test", "expiration_days", "14"),
+					resource.TestCheckResourceAttrSet("lightdash_service_account.test", "rotated_at"),
+				),
+			},
+		},
+	})
+}
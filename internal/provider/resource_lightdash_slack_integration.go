@@ -0,0 +1,304 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                = &slackIntegrationResource{}
+	_ resource.ResourceWithConfigure   = &slackIntegrationResource{}
+	_ resource.ResourceWithImportState = &slackIntegrationResource{}
+)
+
+func NewSlackIntegrationResource() resource.Resource {
+	return &slackIntegrationResource{}
+}
+
+type slackIntegrationResource struct {
+	client *api.Client
+}
+
+type slackIntegrationResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	OrganizationUUID      types.String `tfsdk:"organization_uuid"`
+	NotificationChannelID types.String `tfsdk:"notification_channel_id"`
+	AIDefaultChannelID    types.String `tfsdk:"ai_default_channel_id"`
+	AppProfilePhotoURL    types.String `tfsdk:"app_profile_photo_url"`
+	AIThreadAccessConsent types.Bool   `tfsdk:"ai_thread_access_consent"`
+	AIRequireOAuth        types.Bool   `tfsdk:"ai_require_oauth"`
+	SlackTeamName         types.String `tfsdk:"slack_team_name"`
+	AppName               types.String `tfsdk:"app_name"`
+	InstalledAt           types.String `tfsdk:"installed_at"`
+}
+
+func (r *slackIntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_integration"
+}
+
+func (r *slackIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_slack_integration.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages the settings of the Slack app installed in a Lightdash organization",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `organizations/<organization_uuid>/slack_integration`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the organization the Slack app is installed in.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Slack channel that receives scheduled delivery failures and other notifications.",
+				Optional:            true,
+			},
+			"ai_default_channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Slack channel where users can talk to any AI agent of the organization.",
+				Optional:            true,
+			},
+			"app_profile_photo_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the profile photo of the Slack app.",
+				Optional:            true,
+			},
+			"ai_thread_access_consent": schema.BoolAttribute{
+				MarkdownDescription: "Whether AI agents can read the messages of the Slack threads they are mentioned in. Unset keeps the current value.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ai_require_oauth": schema.BoolAttribute{
+				MarkdownDescription: "Whether users have to link their Lightdash account before using AI agents in Slack. Unset keeps the current value.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slack_team_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Slack workspace the app is installed in.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Slack app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"installed_at": schema.StringAttribute{
+				MarkdownDescription: "The timestamp when the Slack app was installed.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *slackIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *slackIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan slackIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationUUID := plan.OrganizationUUID.ValueString()
+	resp.Diagnostics.Append(validateOrganizationUUID(r.client, organizationUUID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSlackIntegrationService(r.client)
+	settings, err := service.UpdateSlackSettings(ctx, slackSettingsFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Slack integration", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Updated the Slack integration of organization %s", organizationUUID))
+
+	plan.ID = types.StringValue(getSlackIntegrationResourceID(organizationUUID))
+	setSlackIntegrationResourceFromSettings(&plan, settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *slackIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state slackIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSlackIntegrationService(r.client)
+	settings, err := service.GetSlackSettings(ctx)
+	if err != nil {
+		if errors.Is(err, services.ErrSlackNotInstalled) {
+			tflog.Warn(ctx, "The Slack app is not installed anymore, removing the Slack integration from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading Slack integration", err.Error())
+		return
+	}
+	setSlackIntegrationResourceFromSettings(&state, settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *slackIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan slackIntegrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSlackIntegrationService(r.client)
+	settings, err := service.UpdateSlackSettings(ctx, slackSettingsFromModel(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Slack integration", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Updated the Slack integration of organization %s", plan.OrganizationUUID.ValueString()))
+	setSlackIntegrationResourceFromSettings(&plan, settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete clears the channels and the profile photo. The Slack app stays installed,
+// since installing it again requires the OAuth flow in the Lightdash UI.
+func (r *slackIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state slackIntegrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSlackIntegrationService(r.client)
+	if _, err := service.UpdateSlackSettings(ctx, services.SlackSettings{}); err != nil {
+		if errors.Is(err, services.ErrSlackNotInstalled) {
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting Slack integration", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Cleared the Slack integration settings of organization %s", state.OrganizationUUID.ValueString()))
+}
+
+func (r *slackIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractSlackIntegrationResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	organizationUUID := extracted[0]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_uuid"), organizationUUID)...)
+}
+
+func slackSettingsFromModel(model *slackIntegrationResourceModel) services.SlackSettings {
+	return services.SlackSettings{
+		NotificationChannel:   model.NotificationChannelID.ValueStringPointer(),
+		AppProfilePhotoURL:    model.AppProfilePhotoURL.ValueStringPointer(),
+		AIThreadAccessConsent: knownBoolPointer(model.AIThreadAccessConsent),
+		AIRequireOAuth:        knownBoolPointer(model.AIRequireOAuth),
+		AIMultiAgentChannelID: model.AIDefaultChannelID.ValueStringPointer(),
+	}
+}
+
+// knownBoolPointer returns nil for an unset or unknown value, so that the current setting is kept.
+func knownBoolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueBoolPointer()
+}
+
+func setSlackIntegrationResourceFromSettings(model *slackIntegrationResourceModel, settings *apiv1.SlackSettingsV1) {
+	model.NotificationChannelID = nonEmptyStringValue(settings.NotificationChannel)
+	model.AIDefaultChannelID = nonEmptyStringValue(settings.AIMultiAgentChannelID)
+	model.AppProfilePhotoURL = nonEmptyStringValue(settings.AppProfilePhotoURL)
+	model.AIThreadAccessConsent = types.BoolValue(settings.AIThreadAccessConsent != nil && *settings.AIThreadAccessConsent)
+	model.AIRequireOAuth = types.BoolValue(settings.AIRequireOAuth != nil && *settings.AIRequireOAuth)
+	model.SlackTeamName = types.StringValue(settings.SlackTeamName)
+	model.AppName = nonEmptyStringValue(settings.AppName)
+	model.InstalledAt = types.StringValue(settings.CreatedAt)
+}
+
+func getSlackIntegrationResourceID(organizationUUID string) string {
+	return fmt.Sprintf("organizations/%s/slack_integration", organizationUUID)
+}
+
+func extractSlackIntegrationResourceID(input string) ([]string, error) {
+	pattern := `^organizations/([^/]+)/slack_integration$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0]}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestSlackIntegrationResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getSlackIntegrationResourceID("org-uuid")
+	if id != "organizations/org-uuid/slack_integration" {
+		t.Errorf("unexpected resource ID: %s", id)
+	}
+
+	extracted, err := extractSlackIntegrationResourceID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if extracted[0] != "org-uuid" {
+		t.Errorf("got %q, want %q", extracted[0], "org-uuid")
+	}
+}
+
+func TestSlackSettingsFromModel(t *testing.T) {
+	t.Parallel()
+
+	settings := slackSettingsFromModel(&slackIntegrationResourceModel{
+		NotificationChannelID: types.StringValue("C0123"),
+		AIDefaultChannelID:    types.StringNull(),
+		AppProfilePhotoURL:    types.StringNull(),
+		AIThreadAccessConsent: types.BoolUnknown(),
+		AIRequireOAuth:        types.BoolValue(false),
+	})
+	if settings.NotificationChannel == nil || *settings.NotificationChannel != "C0123" {
+		t.Errorf("unexpected notification channel: %v", settings.NotificationChannel)
+	}
+	if settings.AIMultiAgentChannelID != nil || settings.AppProfilePhotoURL != nil {
+		t.Errorf("expected unset settings to be cleared: %+v", settings)
+	}
+	// Unknown booleans keep the current value, while known ones are sent.
+	if settings.AIThreadAccessConsent != nil {
+		t.Errorf("expected an unknown setting to be nil, got %v", *settings.AIThreadAccessConsent)
+	}
+	if settings.AIRequireOAuth == nil || *settings.AIRequireOAuth {
+		t.Errorf("expected ai_require_oauth to be false, got %v", settings.AIRequireOAuth)
+	}
+}
+
+func TestSetSlackIntegrationResourceFromSettings(t *testing.T) {
+	t.Parallel()
+
+	channel := "C0123"
+	empty := ""
+	var model slackIntegrationResourceModel
+	setSlackIntegrationResourceFromSettings(&model, &apiv1.SlackSettingsV1{
+		SlackTeamName:       "Example",
+		CreatedAt:           "2024-01-01T00:00:00.000Z",
+		NotificationChannel: &channel,
+		AppProfilePhotoURL:  &empty,
+	})
+
+	if model.NotificationChannelID.ValueString() != "C0123" || model.SlackTeamName.ValueString() != "Example" {
+		t.Errorf("unexpected model: %+v", model)
+	}
+	if !model.AppProfilePhotoURL.IsNull() || !model.AIDefaultChannelID.IsNull() {
+		t.Errorf("expected empty settings to be null: %+v", model)
+	}
+	if model.AIThreadAccessConsent.ValueBool() || model.AIRequireOAuth.IsNull() {
+		t.Errorf("expected missing booleans to be false: %+v", model)
+	}
+}
+
+func TestAccSlackIntegrationResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_slack_integration")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_slack_integration", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_slack_integration.test", "slack_team_name"),
+					resource.TestCheckResourceAttrPair(
+						"lightdash_slack_integration.test", "notification_channel_id",
+						"data.lightdash_slack_channels.test", "channels.0.channel_id",
+					),
+				),
+			},
+			{
+				ResourceName:      "lightdash_slack_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
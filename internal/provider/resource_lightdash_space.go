@@ -16,7 +16,9 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,6 +33,7 @@ import (
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
 	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -38,6 +41,7 @@ var (
 	_ resource.Resource                = &spaceResource{}
 	_ resource.ResourceWithConfigure   = &spaceResource{}
 	_ resource.ResourceWithImportState = &spaceResource{}
+	_ resource.ResourceWithModifyPlan  = &spaceResource{}
 )
 
 func NewSpaceResource() resource.Resource {
@@ -55,16 +59,26 @@ type spaceResourceModel struct {
 	ID types.String `tfsdk:"id"`
 	// The response from the API does not contain the organization UUID right now.
 	// OrganizationUUID types.String `tfsdk:"organization_uuid"`
-	ProjectUUID      types.String `tfsdk:"project_uuid"`
-	ParentSpaceUUID  types.String `tfsdk:"parent_space_uuid"`
-	SpaceUUID        types.String `tfsdk:"space_uuid"`
-	IsPrivate        types.Bool   `tfsdk:"is_private"`
-	SpaceName        types.String `tfsdk:"name"`
-	DeleteProtection types.Bool   `tfsdk:"deletion_protection"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	LastUpdated      types.String `tfsdk:"last_updated"`
-	MemberAccessList types.Set    `tfsdk:"access"`
-	GroupAccessList  types.Set    `tfsdk:"group_access"`
+	ProjectUUID                   types.String `tfsdk:"project_uuid"`
+	ParentSpaceUUID               types.String `tfsdk:"parent_space_uuid"`
+	SpaceUUID                     types.String `tfsdk:"space_uuid"`
+	IsPrivate                     types.Bool   `tfsdk:"is_private"`
+	SpaceName                     types.String `tfsdk:"name"`
+	DeleteProtection              types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy                  types.String `tfsdk:"force_destroy"`
+	ForceDestroyFallbackSpaceUUID types.String `tfsdk:"force_destroy_fallback_space_uuid"`
+	CreatedAt                     types.String `tfsdk:"created_at"`
+	LastUpdated                   types.String `tfsdk:"last_updated"`
+	ManageAccess                  types.Bool   `tfsdk:"manage_access"`
+	MemberAccessList              types.Set    `tfsdk:"access"`
+	GroupAccessList               types.Set    `tfsdk:"group_access"`
+	EffectiveAccess               types.Set    `tfsdk:"effective_access"`
+}
+
+// spaceManagesAccess reports whether the access and group_access blocks are authoritative.
+// An unset manage_access means true.
+func spaceManagesAccess(model spaceResourceModel) bool {
+	return model.ManageAccess.IsNull() || model.ManageAccess.IsUnknown() || model.ManageAccess.ValueBool()
 }
 
 func configuredSpaceIsPrivate(config spaceResourceModel) *bool {
@@ -74,21 +88,26 @@ func configuredSpaceIsPrivate(config spaceResourceModel) *bool {
 // spaceMemberAccessBlockModel maps the member access data for the user input schema (access block)
 type spaceMemberAccessBlockModel struct {
 	UserUUID  types.String `tfsdk:"user_uuid"`
+	Email     types.String `tfsdk:"email"`
 	SpaceRole types.String `tfsdk:"space_role"`
 	// These fields were removed as they're only for the access_all block
 }
 
-// spaceMemberAccessAllBlockModel maps the member access data from the API to the output-only access_all block
-// This type is primarily kept for documentation purposes. We're now using types.List for the attribute instead,
-// but this model shows the schema of each item in the access_all list.
-//
-//nolint:unused
-type spaceMemberAccessAllBlockModel struct {
-	UserUUID        types.String `tfsdk:"user_uuid"`
-	SpaceRole       types.String `tfsdk:"space_role"`
-	HasDirectAccess types.Bool   `tfsdk:"has_direct_access"`
-	InheritedFrom   types.String `tfsdk:"inherited_from"`
-	ProjectRole     types.String `tfsdk:"project_role"`
+// spaceEffectiveAccessModel maps the member access data from the API to the output-only effective_access attribute
+type spaceEffectiveAccessModel struct {
+	UserUUID    types.String `tfsdk:"user_uuid"`
+	SpaceRole   types.String `tfsdk:"space_role"`
+	Source      types.String `tfsdk:"source"`
+	ProjectRole types.String `tfsdk:"project_role"`
+}
+
+var spaceEffectiveAccessElementType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_uuid":    types.StringType,
+		"space_role":   types.StringType,
+		"source":       types.StringType,
+		"project_role": types.StringType,
+	},
 }
 
 type spaceGroupAccessBlockModel struct {
@@ -154,6 +173,23 @@ func (r *spaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "When set to `true`, prevents the destruction of the space resource by Terraform. Defaults to `false`.",
 				Required:            true,
 			},
+			"manage_access": schema.BoolAttribute{
+				MarkdownDescription: "Whether the `access` and `group_access` blocks manage the direct grants of the space. " +
+					"When `false`, the blocks must be empty and the direct grants are left untouched, so that they can be managed by " +
+					"`lightdash_space_access` and `lightdash_space_group_access`. Defaults to `true`.",
+				Optional: true,
+			},
+			"force_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the child spaces, charts (including SQL charts) and dashboards of the space when it is destroyed. " +
+					"`relocate` moves them to the parent space, or to `force_destroy_fallback_space_uuid` when set. " +
+					"`delete` deletes the child spaces recursively together with all their charts and dashboards; the plan shows a warning listing what will be lost. " +
+					"When unset, a space with child spaces cannot be destroyed. The setting must be applied before the destroy to take effect.",
+				Optional: true,
+			},
+			"force_destroy_fallback_space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space that receives the contents when `force_destroy` is `relocate`. Required to relocate charts and dashboards out of a root space.",
+				Optional:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The timestamp when the space was created.",
 				Computed:            true,
@@ -165,6 +201,30 @@ func (r *spaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The timestamp of the last Terraform update applied to the space.",
 				Computed:            true,
 			},
+			"effective_access": schema.SetNestedAttribute{
+				MarkdownDescription: "Every user who can access the space, with their resolved role and where it comes from. Unlike `access`, it includes access granted through groups, parent spaces, project roles and organization roles.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the Lightdash user.",
+							Computed:            true,
+						},
+						"space_role": schema.StringAttribute{
+							MarkdownDescription: "The resolved role of the user within the space: `admin`, `editor` or `viewer`.",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Where the access comes from: `direct`, `group`, `parent_space`, `project_role`, `organization_role`, `org_admin`, or `unknown` when the API reports an origin the provider does not know.",
+							Computed:            true,
+						},
+						"project_role": schema.StringAttribute{
+							MarkdownDescription: "The role of the user in the project, if any.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"access": schema.SetNestedBlock{
@@ -172,8 +232,13 @@ func (r *spaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"user_uuid": schema.StringAttribute{
-							MarkdownDescription: "The UUID of the Lightdash user.",
-							Required:            true,
+							MarkdownDescription: "The UUID of the Lightdash user. Exactly one of `user_uuid` and `email` must be set; when `email` is set, it is resolved to the user UUID.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the Lightdash user, as an alternative to `user_uuid`. The user must be a member of the organization.",
+							Optional:            true,
 						},
 						"space_role": schema.StringAttribute{
 							MarkdownDescription: "The role assigned to the user within the space. Valid roles are `admin` (Full Access), `editor` (Can Edit), or `viewer` (Can View).",
@@ -240,8 +305,14 @@ func (r *spaceResource) ValidateConfig(ctx context.Context, req resource.Validat
 		errors = append(errors, error)
 	}
 
-	// TODO validate MemberAccessList and GroupAccessList
-	// NOTE WE don't understand how to instantiate the elements of the set in ValidateConfig
+	// Validate configuration for force destroy
+	errors = append(errors, r.validateForceDestroyConfig(ctx, config)...)
+
+	// Validate configuration for member access
+	errors = append(errors, r.validateMemberAccessConfig(ctx, config)...)
+	errors = append(errors, r.validateManageAccessConfig(ctx, config)...)
+
+	// TODO validate GroupAccessList
 
 	// Add errors to the response
 	for _, error := range errors {
@@ -256,6 +327,54 @@ func (r *spaceResource) validateNestedSpaceConfig(_ context.Context, config spac
 	return errors
 }
 
+func (r *spaceResource) validateForceDestroyConfig(_ context.Context, config spaceResourceModel) []error {
+	var errors []error
+	if !config.ForceDestroy.IsNull() && !config.ForceDestroy.IsUnknown() {
+		strategy := models.SpaceForceDestroyStrategy(config.ForceDestroy.ValueString())
+		if !strategy.IsValid() {
+			errors = append(errors, fmt.Errorf("force_destroy must be %q or %q, got %q", models.SPACE_FORCE_DESTROY_RELOCATE, models.SPACE_FORCE_DESTROY_DELETE, strategy))
+		}
+	}
+	if !config.ForceDestroyFallbackSpaceUUID.IsNull() && !config.ForceDestroy.IsUnknown() &&
+		config.ForceDestroy.ValueString() != models.SPACE_FORCE_DESTROY_RELOCATE.String() {
+		errors = append(errors, fmt.Errorf("force_destroy_fallback_space_uuid can only be set when force_destroy is %q", models.SPACE_FORCE_DESTROY_RELOCATE))
+	}
+	return errors
+}
+
+// validateMemberAccessConfig checks that every access block references the user by exactly one of user_uuid and email.
+func (r *spaceResource) validateMemberAccessConfig(ctx context.Context, config spaceResourceModel) []error {
+	var errors []error
+	if config.MemberAccessList.IsNull() || config.MemberAccessList.IsUnknown() {
+		return errors
+	}
+	memberAccess := []spaceMemberAccessBlockModel{}
+	if diags := config.MemberAccessList.ElementsAs(ctx, &memberAccess, false); diags.HasError() {
+		return errors
+	}
+	for _, member := range memberAccess {
+		if member.UserUUID.IsUnknown() || member.Email.IsUnknown() {
+			continue
+		}
+		if member.UserUUID.IsNull() == member.Email.IsNull() {
+			errors = append(errors, fmt.Errorf("exactly one of user_uuid and email must be set in an access block"))
+		}
+	}
+	return errors
+}
+
+// validateManageAccessConfig checks that no access block is set when manage_access is false.
+func (r *spaceResource) validateManageAccessConfig(_ context.Context, config spaceResourceModel) []error {
+	var errors []error
+	if spaceManagesAccess(config) {
+		return errors
+	}
+	if len(config.MemberAccessList.Elements()) > 0 || len(config.GroupAccessList.Elements()) > 0 {
+		errors = append(errors, fmt.Errorf("access and group_access blocks cannot be set when manage_access is false"))
+	}
+	return errors
+}
+
 func (r *spaceResource) validateSpaceVisibilityConfig(_ context.Context, config spaceResourceModel) []error {
 	var errors []error
 	// A public space shouldn't have access lists
@@ -287,6 +406,12 @@ func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	for _, err := range r.resolveMemberAccessEmails(ctx, memberAccess) {
+		resp.Diagnostics.AddError("Error during space creation", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Extract group access details from the 'group_access' block
 	groupAccess := []spaceGroupAccessBlockModel{}
@@ -314,6 +439,17 @@ func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 		for _, err := range controllerErrors {
 			resp.Diagnostics.AddError("Error during space creation", err.Error())
 		}
+		// The space is left behind when the rollback failed, so keep track of it
+		if createdSpaceDetails != nil {
+			state := r.newPartialSpaceState(ctx, plan, memberAccess, createdSpaceDetails, &resp.Diagnostics)
+			state.ID = types.StringValue(fmt.Sprintf("projects/%s/spaces/%s", createdSpaceDetails.ProjectUUID, createdSpaceDetails.SpaceUUID))
+			state.ProjectUUID = types.StringValue(createdSpaceDetails.ProjectUUID)
+			state.SpaceUUID = types.StringValue(createdSpaceDetails.SpaceUUID)
+			currentTime := types.StringValue(time.Now().Format(time.RFC850))
+			state.CreatedAt = currentTime
+			state.LastUpdated = currentTime
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		}
 		return
 	}
 
@@ -344,8 +480,11 @@ func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 	} else {
 		state.ParentSpaceUUID = types.StringNull()
 	}
-	// Preserve deletion protection from plan - this is a Terraform setting
+	// Preserve deletion protection, force destroy and manage access from plan - these are Terraform settings
 	state.DeleteProtection = plan.DeleteProtection
+	state.ManageAccess = plan.ManageAccess
+	state.ForceDestroy = plan.ForceDestroy
+	state.ForceDestroyFallbackSpaceUUID = plan.ForceDestroyFallbackSpaceUUID
 	// Set timestamps
 	// Use the CreatedAt from the controller's SpaceDetails, and set LastUpdated to now
 	currentTime := types.StringValue(time.Now().Format(time.RFC850))
@@ -358,6 +497,9 @@ func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Populate GroupAccessList (direct access from plan)
 	state.GroupAccessList = r.populateGroupAccessListSet(ctx, groupAccess, &resp.Diagnostics)
 
+	// Populate EffectiveAccess (all access from API)
+	state.EffectiveAccess = populateEffectiveAccessSet(fetchedSpaceDetails.SpaceAccessMembers, &resp.Diagnostics)
+
 	// Set state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -399,6 +541,9 @@ func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	newState.SpaceUUID = currentState.SpaceUUID
 	newState.CreatedAt = currentState.CreatedAt
 	newState.DeleteProtection = currentState.DeleteProtection
+	newState.ManageAccess = currentState.ManageAccess
+	newState.ForceDestroy = currentState.ForceDestroy
+	newState.ForceDestroyFallbackSpaceUUID = currentState.ForceDestroyFallbackSpaceUUID
 	newState.LastUpdated = currentState.LastUpdated // Read does not update this TF-managed field
 
 	// Update fields from controller response
@@ -422,6 +567,9 @@ func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Restore the GroupAccessList from the current state (representing the plan)
 	newState.GroupAccessList = currentState.GroupAccessList
 
+	// Populate EffectiveAccess (all access from API)
+	newState.EffectiveAccess = populateEffectiveAccessSet(fetchedSpaceDetails.SpaceAccessMembers, &resp.Diagnostics)
+
 	// Set state
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -446,6 +594,12 @@ func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	for _, err := range r.resolveMemberAccessEmails(ctx, memberAccess) {
+		resp.Diagnostics.AddError("Error during space update", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Extract group access details from the 'group_access' block
 	groupAccess := []spaceGroupAccessBlockModel{}
@@ -464,6 +618,8 @@ func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		ParentSpaceUUID: plan.ParentSpaceUUID.ValueStringPointer(),
 		MemberAccess:    convertToControllerMemberAccess(memberAccess), // Convert to controller format
 		GroupAccess:     convertToControllerGroupAccess(groupAccess),   // Convert to controller format
+		// Grants made by other resources are kept when the blocks do not manage access
+		LeaveAccessUnchanged: !spaceManagesAccess(plan),
 	}
 
 	// Update space using controller
@@ -473,6 +629,25 @@ func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		for _, err := range controllerErrors {
 			resp.Diagnostics.AddError("Error during space update", err.Error())
 		}
+		// Persist the space as it exists after the rollback instead of the planned values
+		if updatedSpaceDetails == nil {
+			for _, err := range controllerErrors {
+				if errors.Is(err, services.ErrSpaceNotFound) {
+					resp.State.RemoveResource(ctx)
+					return
+				}
+			}
+			// The space could not be read before any change was made, so it is unchanged
+			resp.Diagnostics.Append(resp.State.Set(ctx, oldState)...)
+			return
+		}
+		state := r.newPartialSpaceState(ctx, plan, memberAccess, updatedSpaceDetails, &resp.Diagnostics)
+		state.ID = oldState.ID
+		state.ProjectUUID = oldState.ProjectUUID
+		state.SpaceUUID = oldState.SpaceUUID
+		state.CreatedAt = oldState.CreatedAt
+		state.LastUpdated = oldState.LastUpdated
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 		return // Stop if controller reported errors
 	}
 	if updatedSpaceDetails == nil {
@@ -501,18 +676,255 @@ func (r *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	updatedState.DeleteProtection = plan.DeleteProtection // From plan
-	updatedState.CreatedAt = oldState.CreatedAt           // Preserve creation timestamp
+	updatedState.ManageAccess = plan.ManageAccess
+	updatedState.ForceDestroy = plan.ForceDestroy
+	updatedState.ForceDestroyFallbackSpaceUUID = plan.ForceDestroyFallbackSpaceUUID
+	updatedState.CreatedAt = oldState.CreatedAt // Preserve creation timestamp
 	updatedState.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Convert the slices to Set types for state
 	updatedState.MemberAccessList = r.populateMemberAccessListSet(ctx, memberAccess, &resp.Diagnostics)
 	updatedState.GroupAccessList = r.populateGroupAccessListSet(ctx, groupAccess, &resp.Diagnostics)
+	updatedState.EffectiveAccess = populateEffectiveAccessSet(updatedSpaceDetails.SpaceAccessMembers, &resp.Diagnostics)
+	if !plan.EffectiveAccess.IsUnknown() {
+		// The plan kept the value from the state; changes made elsewhere, such as on a parent space, are picked up on the next refresh
+		updatedState.EffectiveAccess = plan.EffectiveAccess
+	}
 
 	// Set state
 	diags = resp.State.Set(ctx, updatedState)
 	resp.Diagnostics.Append(diags...)
 }
 
+// ModifyPlan resolves the emails of access blocks, validates new access entries, keeps effective_access
+// known in update plans that do not change access, and checks a planned destroy against force_destroy.
+// With "delete", it warns about every nested space, chart and dashboard that will be lost.
+// With "relocate", it fails early when the charts and dashboards of a root space have nowhere to go.
+func (r *spaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		r.modifyMemberAccessPlan(ctx, resp)
+		if !resp.Diagnostics.HasError() {
+			r.validateAccessPlan(ctx, req, resp)
+		}
+		if !req.State.Raw.IsNull() && !resp.Diagnostics.HasError() {
+			r.modifyEffectiveAccessPlan(ctx, req, resp)
+		}
+		return
+	}
+	// Only planned destroys of existing spaces are checked
+	if req.State.Raw.IsNull() || r.spaceController == nil {
+		return
+	}
+
+	var state spaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.DeleteProtection.ValueBool() || state.ForceDestroy.IsNull() {
+		return
+	}
+
+	projectUUID := state.ProjectUUID.ValueString()
+	spaceUUID := state.SpaceUUID.ValueString()
+	switch models.SpaceForceDestroyStrategy(state.ForceDestroy.ValueString()) {
+	case models.SPACE_FORCE_DESTROY_DELETE:
+		tree, err := r.spaceController.GetSpaceContentTree(ctx, projectUUID, spaceUUID)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not list space contents",
+				fmt.Sprintf("Could not list the contents of space %s that force_destroy will delete: %s", spaceUUID, err.Error()),
+			)
+			return
+		}
+		if tree.IsEmpty() {
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Space contents will be deleted",
+			fmt.Sprintf("Destroying space %q with force_destroy = \"delete\" permanently deletes:\n  - %s",
+				tree.SpaceName, strings.Join(tree.Describe(), "\n  - ")),
+		)
+	case models.SPACE_FORCE_DESTROY_RELOCATE:
+		target, err := r.spaceController.GetSpaceRelocationTarget(ctx, projectUUID, spaceUUID, state.ForceDestroyFallbackSpaceUUID.ValueStringPointer())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not list space contents",
+				fmt.Sprintf("Could not find where force_destroy will relocate the contents of space %s: %s", spaceUUID, err.Error()),
+			)
+			return
+		}
+		if target != nil {
+			return
+		}
+		tree, err := r.spaceController.GetSpaceContentTree(ctx, projectUUID, spaceUUID)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Could not list space contents",
+				fmt.Sprintf("Could not list the contents of space %s that force_destroy will relocate: %s", spaceUUID, err.Error()),
+			)
+			return
+		}
+		if len(tree.Contents) > 0 {
+			resp.Diagnostics.AddError(
+				"Cannot relocate space contents",
+				fmt.Sprintf("Space %q is a root space and holds charts or dashboards. Set force_destroy_fallback_space_uuid and apply it before destroying the space.", tree.SpaceName),
+			)
+		}
+	}
+}
+
+// modifyMemberAccessPlan fills in the user UUID of access blocks that reference a user by email,
+// so that unknown emails fail at plan time. Emails that are not known yet are resolved on apply.
+func (r *spaceResource) modifyMemberAccessPlan(ctx context.Context, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+	var plan spaceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.MemberAccessList.IsNull() || plan.MemberAccessList.IsUnknown() {
+		return
+	}
+
+	memberAccess := []spaceMemberAccessBlockModel{}
+	resp.Diagnostics.Append(plan.MemberAccessList.ElementsAs(ctx, &memberAccess, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, err := range r.resolveMemberAccessEmails(ctx, memberAccess) {
+		resp.Diagnostics.AddAttributeError(path.Root("access"), "Unknown user email", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberAccessSet, diags := convertToMemberAccessSet(memberAccess)
+	resp.Diagnostics.Append(diags...)
+	if !plan.MemberAccessList.Equal(memberAccessSet) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access"), memberAccessSet)...)
+	}
+}
+
+// validateAccessPlan runs the access validations of the space creation at plan time, so that
+// an invalid user or group fails before any resource is changed. Only the known entries that
+// are not in the state yet are checked.
+func (r *spaceResource) validateAccessPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.spaceController == nil {
+		return
+	}
+	var plan, state spaceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.ProjectUUID.IsNull() || plan.ProjectUUID.IsUnknown() {
+		return
+	}
+
+	memberPaths := newSpaceAccessEntries(plan.MemberAccessList, state.MemberAccessList, "access", "user_uuid")
+	groupPaths := newSpaceAccessEntries(plan.GroupAccessList, state.GroupAccessList, "group_access", "group_uuid")
+	if len(memberPaths) == 0 && len(groupPaths) == 0 {
+		return
+	}
+	memberAccess := []models.SpaceAccessMember{}
+	for userUUID := range memberPaths {
+		memberAccess = append(memberAccess, models.SpaceAccessMember{UserUUID: userUUID})
+	}
+	groupAccess := []models.SpaceAccessGroup{}
+	for groupUUID := range groupPaths {
+		groupAccess = append(groupAccess, models.SpaceAccessGroup{GroupUUID: groupUUID})
+	}
+
+	for _, err := range r.spaceController.ValidateSpaceAccess(ctx, plan.ProjectUUID.ValueString(), memberAccess, groupAccess) {
+		var accessErr *controllers.SpaceAccessValidationError
+		switch {
+		case errors.As(err, &accessErr) && accessErr.UserUUID != "":
+			resp.Diagnostics.AddAttributeError(memberPaths[accessErr.UserUUID], "Invalid space access", err.Error())
+		case errors.As(err, &accessErr) && accessErr.GroupUUID != "":
+			resp.Diagnostics.AddAttributeError(groupPaths[accessErr.GroupUUID], "Invalid space group access", err.Error())
+		default:
+			resp.Diagnostics.AddWarning("Could not validate space access", err.Error())
+		}
+	}
+}
+
+// newSpaceAccessEntries returns the path of every element of an access set whose UUID attribute
+// is known and not present in the previous set, keyed by that UUID.
+func newSpaceAccessEntries(planned, previous types.Set, attributeName, uuidAttribute string) map[string]path.Path {
+	entries := map[string]path.Path{}
+	if planned.IsNull() || planned.IsUnknown() {
+		return entries
+	}
+	existing := map[string]bool{}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		for _, element := range previous.Elements() {
+			if object, ok := element.(types.Object); ok {
+				if uuid, ok := object.Attributes()[uuidAttribute].(types.String); ok {
+					existing[uuid.ValueString()] = true
+				}
+			}
+		}
+	}
+	for _, element := range planned.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		uuid, ok := object.Attributes()[uuidAttribute].(types.String)
+		if !ok || uuid.IsNull() || uuid.IsUnknown() || existing[uuid.ValueString()] {
+			continue
+		}
+		entries[uuid.ValueString()] = path.Root(attributeName).AtSetValue(element)
+	}
+	return entries
+}
+
+// resolveMemberAccessEmails sets the user UUID of the access blocks that only have a known email.
+func (r *spaceResource) resolveMemberAccessEmails(ctx context.Context, memberAccess []spaceMemberAccessBlockModel) []error {
+	var errors []error
+	for i, member := range memberAccess {
+		if !member.UserUUID.IsNull() && !member.UserUUID.IsUnknown() {
+			continue
+		}
+		if member.Email.IsNull() || member.Email.IsUnknown() {
+			continue
+		}
+		orgMember, err := services.GetOrganizationMembersService(r.client).GetOrganizationMemberByEmail(ctx, member.Email.ValueString())
+		if err != nil {
+			errors = append(errors, fmt.Errorf("could not resolve access email %q: %w", member.Email.ValueString(), err))
+			continue
+		}
+		memberAccess[i].UserUUID = types.StringValue(orgMember.UserUUID)
+	}
+	return errors
+}
+
+// modifyEffectiveAccessPlan copies effective_access from the state when none of the attributes
+// that decide who can access the space change. Otherwise it is left unknown until the apply.
+func (r *spaceResource) modifyEffectiveAccessPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state spaceResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !spaceAccessSettingsUnchanged(plan, state) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_access"), state.EffectiveAccess)...)
+}
+
+// spaceAccessSettingsUnchanged reports whether a plan keeps the settings that decide the effective access of a space.
+func spaceAccessSettingsUnchanged(plan, state spaceResourceModel) bool {
+	if plan.IsPrivate.IsUnknown() {
+		return false
+	}
+	return plan.ParentSpaceUUID.Equal(state.ParentSpaceUUID) &&
+		plan.IsPrivate.Equal(state.IsPrivate) &&
+		plan.MemberAccessList.Equal(state.MemberAccessList) &&
+		plan.GroupAccessList.Equal(state.GroupAccessList)
+}
+
 func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
 	var state spaceResourceModel
@@ -527,7 +939,9 @@ func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	spaceUUID := state.SpaceUUID.ValueString()
 	deletionProtection := state.DeleteProtection.ValueBool()
 
-	tflog.Debug(ctx, "Deleting space", map[string]any{"projectUUID": projectUUID, "spaceUUID": spaceUUID, "deletionProtection": deletionProtection})
+	forceDestroy := models.SpaceForceDestroyStrategy(state.ForceDestroy.ValueString())
+
+	tflog.Debug(ctx, "Deleting space", map[string]any{"projectUUID": projectUUID, "spaceUUID": spaceUUID, "deletionProtection": deletionProtection, "forceDestroy": forceDestroy})
 
 	err := r.spaceController.DeleteSpace(
 		ctx,
@@ -535,6 +949,8 @@ func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			ProjectUUID:        projectUUID,
 			SpaceUUID:          spaceUUID,
 			DeletionProtection: deletionProtection,
+			ForceDestroy:       forceDestroy,
+			FallbackSpaceUUID:  state.ForceDestroyFallbackSpaceUUID.ValueStringPointer(),
 		},
 	)
 	if err != nil {
@@ -592,6 +1008,7 @@ func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStat
 			if member.HasDirectSpaceMemberAccess() {
 				directMemberAccessListForImport = append(directMemberAccessListForImport, spaceMemberAccessBlockModel{
 					UserUUID:  types.StringValue(member.UserUUID),
+					Email:     types.StringNull(),
 					SpaceRole: types.StringValue(string(member.SpaceRole)),
 					// HasDirectAccess and InheritedFrom are not part of 'access' input schema, so not set here.
 				})
@@ -626,6 +1043,10 @@ func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStat
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_access"), groupAccessSet)...)
 	}
 
+	// Populate 'effective_access' with all members
+	effectiveAccess := populateEffectiveAccessSet(spaceDetailsFromController.SpaceAccessMembers, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("effective_access"), effectiveAccess)...)
+
 	// Set timestamps
 	// Use the CreatedAt from the controller's SpaceDetails, and set LastUpdated to now
 	// As mentioned, CreatedAt is not expected from Lightdash, so we'll use current time
@@ -634,6 +1055,66 @@ func (r *spaceResource) ImportState(ctx context.Context, req resource.ImportStat
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("last_updated"), currentTime)...)
 }
 
+// newPartialSpaceState builds the state of a space whose create or update failed part way.
+// The name, visibility, parent and access come from the space as it exists, so that the next
+// plan shows the remaining changes. Emails are kept for members that were configured by email.
+func (r *spaceResource) newPartialSpaceState(
+	ctx context.Context,
+	plan spaceResourceModel,
+	plannedMemberAccess []spaceMemberAccessBlockModel,
+	spaceDetails *models.SpaceDetails,
+	diags *diag.Diagnostics,
+) spaceResourceModel {
+	var state spaceResourceModel
+	state.SpaceName = types.StringValue(spaceDetails.SpaceName)
+	state.IsPrivate = types.BoolValue(spaceDetails.IsPrivate)
+	if spaceDetails.ParentSpaceUUID != nil {
+		state.ParentSpaceUUID = types.StringValue(*spaceDetails.ParentSpaceUUID)
+	} else {
+		state.ParentSpaceUUID = types.StringNull()
+	}
+	state.DeleteProtection = plan.DeleteProtection
+	state.ManageAccess = plan.ManageAccess
+	state.ForceDestroy = plan.ForceDestroy
+	state.ForceDestroyFallbackSpaceUUID = plan.ForceDestroyFallbackSpaceUUID
+
+	plannedEmails := map[string]types.String{}
+	for _, member := range plannedMemberAccess {
+		plannedEmails[member.UserUUID.ValueString()] = member.Email
+	}
+	memberAccess := []spaceMemberAccessBlockModel{}
+	for _, member := range spaceDetails.SpaceAccessMembers {
+		if !member.HasDirectSpaceMemberAccess() {
+			continue
+		}
+		email, ok := plannedEmails[member.UserUUID]
+		if !ok {
+			email = types.StringNull()
+		}
+		memberAccess = append(memberAccess, spaceMemberAccessBlockModel{
+			UserUUID:  types.StringValue(member.UserUUID),
+			Email:     email,
+			SpaceRole: types.StringValue(member.SpaceRole.String()),
+		})
+	}
+	groupAccess := []spaceGroupAccessBlockModel{}
+	for _, group := range spaceDetails.SpaceAccessGroups {
+		groupAccess = append(groupAccess, spaceGroupAccessBlockModel{
+			GroupUUID: types.StringValue(group.GroupUUID),
+			SpaceRole: types.StringValue(group.SpaceRole.String()),
+		})
+	}
+	// Grants that the blocks do not manage stay out of them
+	if !spaceManagesAccess(plan) {
+		memberAccess = []spaceMemberAccessBlockModel{}
+		groupAccess = []spaceGroupAccessBlockModel{}
+	}
+	state.MemberAccessList = r.populateMemberAccessListSet(ctx, memberAccess, diags)
+	state.GroupAccessList = r.populateGroupAccessListSet(ctx, groupAccess, diags)
+	state.EffectiveAccess = populateEffectiveAccessSet(spaceDetails.SpaceAccessMembers, diags)
+	return state
+}
+
 func convertToControllerMemberAccess(memberAccess []spaceMemberAccessBlockModel) []models.SpaceAccessMember {
 	controllerAccess := make([]models.SpaceAccessMember, 0, len(memberAccess))
 	for _, member := range memberAccess {
@@ -664,6 +1145,7 @@ func convertToMemberAccessSet(memberAccess []spaceMemberAccessBlockModel) (types
 	elementType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"user_uuid":  types.StringType,
+			"email":      types.StringType,
 			"space_role": types.StringType,
 		},
 	}
@@ -674,6 +1156,7 @@ func convertToMemberAccessSet(memberAccess []spaceMemberAccessBlockModel) (types
 			elementType.AttrTypes,
 			map[string]attr.Value{
 				"user_uuid":  access.UserUUID,
+				"email":      access.Email,
 				"space_role": access.SpaceRole,
 			},
 		)
@@ -728,6 +1211,7 @@ func (r *spaceResource) populateMemberAccessListSet(_ context.Context, members [
 		elementType := types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"user_uuid":  types.StringType,
+				"email":      types.StringType,
 				"space_role": types.StringType,
 			},
 		}
@@ -741,6 +1225,7 @@ func (r *spaceResource) populateMemberAccessListSet(_ context.Context, members [
 		elementType := types.ObjectType{
 			AttrTypes: map[string]attr.Type{
 				"user_uuid":  types.StringType,
+				"email":      types.StringType,
 				"space_role": types.StringType,
 			},
 		}
@@ -781,3 +1266,28 @@ func (r *spaceResource) populateGroupAccessListSet(ctx context.Context, groups [
 
 	return groupAccessSet
 }
+
+// populateEffectiveAccessSet converts the members returned by the API to a types.Set.
+// This is used for the 'effective_access' attribute, representing all member access with its source.
+func populateEffectiveAccessSet(members []models.SpaceMemberAccess, diags *diag.Diagnostics) types.Set {
+	effectiveAccess := make([]spaceEffectiveAccessModel, 0, len(members))
+	for _, member := range members {
+		projectRole := types.StringNull()
+		if member.ProjectRole != nil && *member.ProjectRole != "" {
+			projectRole = types.StringValue(*member.ProjectRole)
+		}
+		effectiveAccess = append(effectiveAccess, spaceEffectiveAccessModel{
+			UserUUID:    types.StringValue(member.UserUUID),
+			SpaceRole:   types.StringValue(string(member.SpaceRole)),
+			Source:      types.StringValue(member.GetSpaceAccessSource().String()),
+			ProjectRole: projectRole,
+		})
+	}
+
+	effectiveAccessSet, conversionDiags := types.SetValueFrom(context.Background(), spaceEffectiveAccessElementType, effectiveAccess)
+	diags.Append(conversionDiags...)
+	if conversionDiags.HasError() {
+		return types.SetValueMust(spaceEffectiveAccessElementType, nil)
+	}
+	return effectiveAccessSet
+}
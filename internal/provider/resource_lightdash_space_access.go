@@ -0,0 +1,297 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &spaceAccessResource{}
+	_ resource.ResourceWithConfigure      = &spaceAccessResource{}
+	_ resource.ResourceWithImportState    = &spaceAccessResource{}
+	_ resource.ResourceWithValidateConfig = &spaceAccessResource{}
+)
+
+func NewSpaceAccessResource() resource.Resource {
+	return &spaceAccessResource{}
+}
+
+// spaceAccessResource manages a single direct user grant on a space without touching other grants.
+type spaceAccessResource struct {
+	client          *api.Client
+	spaceService    *services.SpaceService
+	spaceController *controllers.SpaceController
+}
+
+type spaceAccessResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectUUID types.String `tfsdk:"project_uuid"`
+	SpaceUUID   types.String `tfsdk:"space_uuid"`
+	UserUUID    types.String `tfsdk:"user_uuid"`
+	SpaceRole   types.String `tfsdk:"space_role"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func (r *spaceAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_access"
+}
+
+func (r *spaceAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_space_access.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Grants a user access to a Lightdash space without managing other grants",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/spaces/<space_uuid>/access/<user_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the user. The user must be a member of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_role": schema.StringAttribute{
+				MarkdownDescription: "The role of the user within the space. Valid roles are `admin` (Full Access), `editor` (Can Edit), or `viewer` (Can View).",
+				Required:            true,
+			},
+			"last_updated": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the last Terraform update applied to the grant.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *spaceAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.spaceService = services.NewSpaceService(client)
+	r.spaceController = controllers.NewSpaceController(client)
+}
+
+func (r *spaceAccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config spaceAccessResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := validateSpaceRoleConfig(config.SpaceRole); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("space_role"), "Invalid space role", err.Error())
+	}
+}
+
+func (r *spaceAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spaceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID := plan.ProjectUUID.ValueString()
+	spaceUUID := plan.SpaceUUID.ValueString()
+	userUUID := plan.UserUUID.ValueString()
+	for _, err := range r.spaceController.ValidateSpaceAccess(ctx, projectUUID, []models.SpaceAccessMember{{UserUUID: userUUID}}, nil) {
+		resp.Diagnostics.AddError("Error granting space access", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.spaceService.AddUserToSpace(ctx, projectUUID, spaceUUID, userUUID, models.SpaceMemberRole(plan.SpaceRole.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error granting space access",
+			fmt.Sprintf("Could not grant user %s access to space %s: %s", userUUID, spaceUUID, err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Granted user %s the %s role on space %s", userUUID, plan.SpaceRole.ValueString(), spaceUUID))
+
+	plan.ID = types.StringValue(getSpaceAccessResourceID(projectUUID, spaceUUID, userUUID))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spaceAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state spaceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	space, err := r.spaceController.GetSpace(ctx, state.ProjectUUID.ValueString(), state.SpaceUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrSpaceNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Space %s not found, removing the access grant from state", state.SpaceUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading space access", err.Error())
+		return
+	}
+
+	member := findDirectSpaceMember(space, state.UserUUID.ValueString())
+	if member == nil {
+		tflog.Warn(ctx, fmt.Sprintf("User %s no longer has direct access to space %s, removing from state", state.UserUUID.ValueString(), state.SpaceUUID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.SpaceRole = types.StringValue(member.SpaceRole.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *spaceAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan spaceAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Granting access again replaces the role of the user
+	err := r.spaceService.AddUserToSpace(ctx, plan.ProjectUUID.ValueString(), plan.SpaceUUID.ValueString(), plan.UserUUID.ValueString(), models.SpaceMemberRole(plan.SpaceRole.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating space access",
+			fmt.Sprintf("Could not update the role of user %s on space %s: %s", plan.UserUUID.ValueString(), plan.SpaceUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spaceAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spaceAccessResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.spaceService.RemoveUserFromSpace(ctx, state.ProjectUUID.ValueString(), state.SpaceUUID.ValueString(), state.UserUUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error revoking space access",
+			fmt.Sprintf("Could not revoke access of user %s to space %s: %s", state.UserUUID.ValueString(), state.SpaceUUID.ValueString(), err.Error()),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Revoked access of user %s to space %s", state.UserUUID.ValueString(), state.SpaceUUID.ValueString()))
+}
+
+func (r *spaceAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractSpaceAccessResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), extracted[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_uuid"), extracted[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_uuid"), extracted[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("last_updated"), time.Now().Format(time.RFC850))...)
+}
+
+// findDirectSpaceMember returns the direct grant of a user on a space, or nil if there is none.
+func findDirectSpaceMember(space *models.SpaceDetails, userUUID string) *models.SpaceMemberAccess {
+	for i, member := range space.SpaceAccessMembers {
+		if member.UserUUID == userUUID && member.HasDirectSpaceMemberAccess() {
+			return &space.SpaceAccessMembers[i]
+		}
+	}
+	return nil
+}
+
+// validateSpaceRoleConfig checks a known space role.
+func validateSpaceRoleConfig(role types.String) error {
+	if role.IsNull() || role.IsUnknown() {
+		return nil
+	}
+	if !models.SpaceMemberRole(role.ValueString()).IsValid() {
+		return fmt.Errorf("space_role must be %q, %q or %q, got %q",
+			models.SPACE_ADMIN_ROLE, models.SPACE_EDITOR_ROLE, models.SPACE_VIEWER_ROLE, role.ValueString())
+	}
+	return nil
+}
+
+func getSpaceAccessResourceID(projectUUID, spaceUUID, userUUID string) string {
+	return fmt.Sprintf("projects/%s/spaces/%s/access/%s", projectUUID, spaceUUID, userUUID)
+}
+
+func extractSpaceAccessResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/spaces/([^/]+)/access/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1], groups[2]}, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestSpaceAccessResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getSpaceAccessResourceID("project-1", "space-1", "user-1")
+	if id != "projects/project-1/spaces/space-1/access/user-1" {
+		t.Fatalf("unexpected resource ID: %s", id)
+	}
+	got, err := extractSpaceAccessResourceID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"project-1", "space-1", "user-1"}) {
+		t.Errorf("unexpected extracted ID: %v", got)
+	}
+
+	for _, input := range []string{
+		"projects/project-1/spaces/space-1",
+		"projects/project-1/spaces/space-1/group_access/group-1",
+		"projects/project-1/spaces/space-1/access/user-1/extra",
+	} {
+		if _, err := extractSpaceAccessResourceID(input); err == nil {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+func TestValidateSpaceRoleConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		role    types.String
+		wantErr bool
+	}{
+		{name: "admin", role: types.StringValue("admin")},
+		{name: "editor", role: types.StringValue("editor")},
+		{name: "viewer", role: types.StringValue("viewer")},
+		{name: "unknown", role: types.StringUnknown()},
+		{name: "invalid", role: types.StringValue("owner"), wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if err := validateSpaceRoleConfig(tc.role); (err != nil) != tc.wantErr {
+				t.Errorf("validateSpaceRoleConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindDirectSpaceMember(t *testing.T) {
+	t.Parallel()
+
+	yes, no := true, false
+	group, empty := "group", ""
+	space := &models.SpaceDetails{
+		SpaceAccessMembers: []models.SpaceMemberAccess{
+			{UserUUID: "direct", SpaceRole: models.SPACE_EDITOR_ROLE, HasDirectAccess: &yes, InheritedFrom: &empty},
+			{UserUUID: "via-group", SpaceRole: models.SPACE_VIEWER_ROLE, HasDirectAccess: &yes, InheritedFrom: &group},
+			{UserUUID: "inherited", SpaceRole: models.SPACE_VIEWER_ROLE, HasDirectAccess: &no},
+		},
+	}
+
+	if member := findDirectSpaceMember(space, "direct"); member == nil || member.SpaceRole != models.SPACE_EDITOR_ROLE {
+		t.Errorf("expected the direct grant, got %v", member)
+	}
+	for _, userUUID := range []string{"via-group", "inherited", "missing"} {
+		if member := findDirectSpaceMember(space, userUUID); member != nil {
+			t.Errorf("expected no direct grant for %s, got %v", userUUID, member)
+		}
+	}
+}
+
+func TestAccSpaceAccessResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_space_access")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_access", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_access", "lifecycle", "020_update_role.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	renameConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_access", "lifecycle", "030_rename_space.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_space_access.test", "id"),
+					resource.TestCheckResourceAttr("lightdash_space_access.test", "space_role", "viewer"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space_access.test", "space_role", "editor"),
+				),
+			},
+			{
+				// Updating a space with manage_access = false keeps the grant
+				Config: providerConfig + renameConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space_access.test", "space_role", "editor"),
+				),
+			},
+			{
+				ResourceName:            "lightdash_space_access.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+func TestSpaceGroupAccessResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getSpaceGroupAccessResourceID("project-1", "space-1", "group-1")
+	if id != "projects/project-1/spaces/space-1/group_access/group-1" {
+		t.Fatalf("unexpected resource ID: %s", id)
+	}
+	got, err := extractSpaceGroupAccessResourceID(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, []string{"project-1", "space-1", "group-1"}) {
+		t.Errorf("unexpected extracted ID: %v", got)
+	}
+	if _, err := extractSpaceGroupAccessResourceID("projects/project-1/spaces/space-1/access/user-1"); err == nil {
+		t.Errorf("expected an error for a user grant ID")
+	}
+}
+
+func TestFindSpaceGroup(t *testing.T) {
+	t.Parallel()
+
+	space := &models.SpaceDetails{
+		SpaceAccessGroups: []models.SpaceAccessGroup{
+			{GroupUUID: "group-1", SpaceRole: models.SPACE_ADMIN_ROLE},
+		},
+	}
+	if group := findSpaceGroup(space, "group-1"); group == nil || group.SpaceRole != models.SPACE_ADMIN_ROLE {
+		t.Errorf("expected the group grant, got %v", group)
+	}
+	if group := findSpaceGroup(space, "group-2"); group != nil {
+		t.Errorf("expected no grant, got %v", group)
+	}
+}
+
+func TestAccSpaceGroupAccessResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_space_group_access")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_group_access", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_group_access", "lifecycle", "020_update_role.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_space_group_access.test", "id"),
+					resource.TestCheckResourceAttr("lightdash_space_group_access.test", "space_role", "viewer"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space_group_access.test", "space_role", "editor"),
+				),
+			},
+			{
+				ResourceName:            "lightdash_space_group_access.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
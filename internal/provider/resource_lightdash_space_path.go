@@ -0,0 +1,298 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                = &spacePathResource{}
+	_ resource.ResourceWithConfigure   = &spacePathResource{}
+	_ resource.ResourceWithImportState = &spacePathResource{}
+)
+
+func NewSpacePathResource() resource.Resource {
+	return &spacePathResource{}
+}
+
+type spacePathResource struct {
+	client          *api.Client
+	spaceController *controllers.SpaceController
+}
+
+type spacePathResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ProjectUUID         types.String `tfsdk:"project_uuid"`
+	Path                types.String `tfsdk:"path"`
+	IsPrivate           types.Bool   `tfsdk:"is_private"`
+	DeleteCreatedSpaces types.Bool   `tfsdk:"delete_created_spaces"`
+	SpaceUUID           types.String `tfsdk:"space_uuid"`
+	SpaceUUIDs          types.List   `tfsdk:"space_uuids"`
+	CreatedSpaceUUIDs   types.List   `tfsdk:"created_space_uuids"`
+}
+
+func (r *spacePathResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_path"
+}
+
+func (r *spacePathResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_space_path.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Ensures a path of nested Lightdash spaces exists",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/space_paths/<path>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Slash-separated path of the space from the root of the project, such as `Marketing/Campaigns/2026`. A slash in a space name is escaped as `\\/`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Whether the spaces created by the resource are private. Nested spaces inherit the visibility of their parent when unset. Existing spaces are not changed.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_created_spaces": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, destroying the resource deletes the spaces it created, deepest first, together with their charts and dashboards. Spaces that already existed are never deleted. Defaults to `false`, which leaves all spaces in place.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the last space of the path.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_uuids": schema.ListAttribute{
+				MarkdownDescription: "The UUIDs of the spaces along the path, from the root space to the last space.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_space_uuids": schema.ListAttribute{
+				MarkdownDescription: "The UUIDs of the spaces created by the resource, from the outermost to the innermost.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *spacePathResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.spaceController = controllers.NewSpaceController(client)
+}
+
+func (r *spacePathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spacePathResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID := plan.ProjectUUID.ValueString()
+	resolved, created, err := r.spaceController.EnsureSpacePath(ctx, controllers.EnsureSpacePathOptions{
+		ProjectUUID: projectUUID,
+		Path:        plan.Path.ValueString(),
+		IsPrivate:   plan.IsPrivate.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error ensuring space path", err.Error())
+		// Keep track of the spaces created before the failure, so that they can be cleaned up
+		if len(created) == 0 {
+			return
+		}
+	}
+	if err == nil {
+		tflog.Info(ctx, fmt.Sprintf("Ensured space path %q in project %s, created %d spaces", plan.Path.ValueString(), projectUUID, len(created)))
+	}
+
+	plan.ID = types.StringValue(getSpacePathResourceID(projectUUID, plan.Path.ValueString()))
+	plan.SpaceUUID = types.StringValue(resolved.SpaceUUID())
+	resp.Diagnostics.Append(setSpacePathResourceUUIDs(ctx, &plan, resolved.SpaceUUIDs, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spacePathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state spacePathResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolved, err := r.spaceController.ResolveSpacePath(ctx, state.ProjectUUID.ValueString(), state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading space path", err.Error())
+		return
+	}
+	if !resolved.IsComplete() {
+		tflog.Warn(ctx, fmt.Sprintf("Space path %q no longer exists, removing from state", state.Path.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	created := []string{}
+	if !state.CreatedSpaceUUIDs.IsNull() {
+		resp.Diagnostics.Append(state.CreatedSpaceUUIDs.ElementsAs(ctx, &created, false)...)
+	}
+	state.SpaceUUID = types.StringValue(resolved.SpaceUUID())
+	resp.Diagnostics.Append(setSpacePathResourceUUIDs(ctx, &state, resolved.SpaceUUIDs, created)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only changes delete_created_spaces, which is a Terraform setting.
+func (r *spacePathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan spacePathResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *spacePathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spacePathResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.DeleteCreatedSpaces.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Leaving the spaces of path %q in place", state.Path.ValueString()))
+		return
+	}
+
+	created := []string{}
+	resp.Diagnostics.Append(state.CreatedSpaceUUIDs.ElementsAs(ctx, &created, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i := len(created) - 1; i >= 0; i-- {
+		err := r.spaceController.DeleteSpace(ctx, controllers.DeleteSpaceOptions{
+			ProjectUUID: state.ProjectUUID.ValueString(),
+			SpaceUUID:   created[i],
+		})
+		if err != nil && !errors.Is(err, services.ErrSpaceNotFound) {
+			resp.Diagnostics.AddError("Error deleting space path", fmt.Sprintf("Could not delete space %s: %s", created[i], err.Error()))
+			return
+		}
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted %d spaces created for path %q", len(created), state.Path.ValueString()))
+}
+
+func (r *spacePathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractSpacePathResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	if _, err := models.ParseSpacePath(extracted[1]); err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_uuid"), extracted[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), extracted[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_created_spaces"), false)...)
+	// Imported spaces were not created by Terraform
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_space_uuids"), []string{})...)
+}
+
+func setSpacePathResourceUUIDs(ctx context.Context, model *spacePathResourceModel, spaceUUIDs, created []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var listDiags diag.Diagnostics
+	model.SpaceUUIDs, listDiags = types.ListValueFrom(ctx, types.StringType, spaceUUIDs)
+	diags.Append(listDiags...)
+	model.CreatedSpaceUUIDs, listDiags = types.ListValueFrom(ctx, types.StringType, created)
+	diags.Append(listDiags...)
+	return diags
+}
+
+func getSpacePathResourceID(projectUUID, spacePath string) string {
+	return fmt.Sprintf("projects/%s/space_paths/%s", projectUUID, spacePath)
+}
+
+func extractSpacePathResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/space_paths/(.+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1]}, nil
+}
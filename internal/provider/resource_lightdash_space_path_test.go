@@ -0,0 +1,147 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGetSpacePathResourceID(t *testing.T) {
+	t.Parallel()
+
+	id := getSpacePathResourceID("project-1", "Marketing/Campaigns/2026")
+	if id != "projects/project-1/space_paths/Marketing/Campaigns/2026" {
+		t.Errorf("unexpected resource ID: %s", id)
+	}
+}
+
+func TestExtractSpacePathResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "single space",
+			input:    "projects/project-1/space_paths/Marketing",
+			expected: []string{"project-1", "Marketing"},
+		},
+		{
+			name:     "nested spaces",
+			input:    "projects/project-1/space_paths/Marketing/Campaigns/2026",
+			expected: []string{"project-1", "Marketing/Campaigns/2026"},
+		},
+		{
+			name:     "escaped slash",
+			input:    `projects/project-1/space_paths/Sales\/Ops/Reports`,
+			expected: []string{"project-1", `Sales\/Ops/Reports`},
+		},
+		{
+			name:    "missing path",
+			input:   "projects/project-1/space_paths/",
+			wantErr: true,
+		},
+		{
+			name:    "wrong collection",
+			input:   "projects/project-1/spaces/space-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := extractSpacePathResourceID(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccSpacePathResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_space_path")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_path", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	overlapConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_path", "lifecycle", "020_overlap.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_space_path.test", "space_uuid"),
+					resource.TestCheckResourceAttr("lightdash_space_path.test", "space_uuids.#", "3"),
+					resource.TestCheckResourceAttr("lightdash_space_path.test", "created_space_uuids.#", "3"),
+					resource.TestCheckResourceAttrPair(
+						"data.lightdash_space.space_path__test",
+						"space_uuid",
+						"lightdash_space_path.test",
+						"space_uuid",
+					),
+					resource.TestCheckResourceAttr("data.lightdash_space.space_path__test", "name", "2026"),
+				),
+			},
+			{
+				Config: providerConfig + overlapConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space_path.overlap", "space_uuids.#", "3"),
+					resource.TestCheckResourceAttr("lightdash_space_path.overlap", "created_space_uuids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"lightdash_space_path.overlap",
+						"space_uuids.1",
+						"lightdash_space_path.test",
+						"space_uuids.1",
+					),
+				),
+			},
+			{
+				ResourceName:            "lightdash_space_path.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"is_private", "delete_created_spaces", "created_space_uuids"},
+			},
+		},
+	})
+}
@@ -15,12 +15,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
 )
 
 // Using the shared testAccPreCheck and testAccProtoV6ProviderFactories from provider_acc_test.go
@@ -67,10 +73,302 @@ func TestConfiguredSpaceIsPrivate(t *testing.T) {
 	}
 }
 
+func TestSpaceResourceValidateForceDestroyConfig(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		config     spaceResourceModel
+		wantErrors int
+	}{
+		{
+			name:       "unset",
+			config:     spaceResourceModel{ForceDestroy: types.StringNull(), ForceDestroyFallbackSpaceUUID: types.StringNull()},
+			wantErrors: 0,
+		},
+		{
+			name:       "delete",
+			config:     spaceResourceModel{ForceDestroy: types.StringValue("delete"), ForceDestroyFallbackSpaceUUID: types.StringNull()},
+			wantErrors: 0,
+		},
+		{
+			name:       "relocate with fallback",
+			config:     spaceResourceModel{ForceDestroy: types.StringValue("relocate"), ForceDestroyFallbackSpaceUUID: types.StringValue("fallback-uuid")},
+			wantErrors: 0,
+		},
+		{
+			name:       "unknown strategy",
+			config:     spaceResourceModel{ForceDestroy: types.StringValue("move"), ForceDestroyFallbackSpaceUUID: types.StringNull()},
+			wantErrors: 1,
+		},
+		{
+			name:       "fallback without relocate",
+			config:     spaceResourceModel{ForceDestroy: types.StringValue("delete"), ForceDestroyFallbackSpaceUUID: types.StringValue("fallback-uuid")},
+			wantErrors: 1,
+		},
+		{
+			name:       "fallback without force_destroy",
+			config:     spaceResourceModel{ForceDestroy: types.StringNull(), ForceDestroyFallbackSpaceUUID: types.StringValue("fallback-uuid")},
+			wantErrors: 1,
+		},
+		{
+			name:       "unknown force_destroy",
+			config:     spaceResourceModel{ForceDestroy: types.StringUnknown(), ForceDestroyFallbackSpaceUUID: types.StringValue("fallback-uuid")},
+			wantErrors: 0,
+		},
+	}
+
+	r := &spaceResource{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errors := r.validateForceDestroyConfig(context.Background(), tc.config)
+			if len(errors) != tc.wantErrors {
+				t.Fatalf("validateForceDestroyConfig() returned %d errors (%v), want %d", len(errors), errors, tc.wantErrors)
+			}
+		})
+	}
+}
+
+func TestSpaceResourceValidateMemberAccessConfig(t *testing.T) {
+	t.Parallel()
+
+	accessSet := func(members ...spaceMemberAccessBlockModel) types.Set {
+		set, diags := convertToMemberAccessSet(members)
+		if diags.HasError() {
+			t.Fatalf("failed to build member set: %v", diags)
+		}
+		return set
+	}
+	viewer := types.StringValue("viewer")
+
+	cases := []struct {
+		name       string
+		access     types.Set
+		wantErrors int
+	}{
+		{
+			name:       "user uuid",
+			access:     accessSet(spaceMemberAccessBlockModel{UserUUID: types.StringValue("user-1"), Email: types.StringNull(), SpaceRole: viewer}),
+			wantErrors: 0,
+		},
+		{
+			name:       "email",
+			access:     accessSet(spaceMemberAccessBlockModel{UserUUID: types.StringNull(), Email: types.StringValue("user@example.com"), SpaceRole: viewer}),
+			wantErrors: 0,
+		},
+		{
+			name:       "unknown email",
+			access:     accessSet(spaceMemberAccessBlockModel{UserUUID: types.StringNull(), Email: types.StringUnknown(), SpaceRole: viewer}),
+			wantErrors: 0,
+		},
+		{
+			name:       "both",
+			access:     accessSet(spaceMemberAccessBlockModel{UserUUID: types.StringValue("user-1"), Email: types.StringValue("user@example.com"), SpaceRole: viewer}),
+			wantErrors: 1,
+		},
+		{
+			name: "neither",
+			access: accessSet(
+				spaceMemberAccessBlockModel{UserUUID: types.StringNull(), Email: types.StringNull(), SpaceRole: viewer},
+				spaceMemberAccessBlockModel{UserUUID: types.StringValue("user-1"), Email: types.StringNull(), SpaceRole: viewer},
+			),
+			wantErrors: 1,
+		},
+	}
+
+	r := &spaceResource{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			errors := r.validateMemberAccessConfig(context.Background(), spaceResourceModel{MemberAccessList: tc.access})
+			if len(errors) != tc.wantErrors {
+				t.Fatalf("validateMemberAccessConfig() returned %d errors (%v), want %d", len(errors), errors, tc.wantErrors)
+			}
+		})
+	}
+}
+
+func TestSpaceResourceValidateManageAccessConfig(t *testing.T) {
+	t.Parallel()
+
+	memberSet, diags := convertToMemberAccessSet([]spaceMemberAccessBlockModel{
+		{UserUUID: types.StringValue("user-1"), Email: types.StringNull(), SpaceRole: types.StringValue("viewer")},
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build member set: %v", diags)
+	}
+	emptyMembers, _ := convertToMemberAccessSet(nil)
+	emptyGroups, _ := convertToGroupAccessSet(nil)
+
+	cases := []struct {
+		name         string
+		manageAccess types.Bool
+		access       types.Set
+		wantManaged  bool
+		wantErrors   int
+	}{
+		{name: "unset with access", manageAccess: types.BoolNull(), access: memberSet, wantManaged: true},
+		{name: "true with access", manageAccess: types.BoolValue(true), access: memberSet, wantManaged: true},
+		{name: "false without access", manageAccess: types.BoolValue(false), access: emptyMembers},
+		{name: "false with access", manageAccess: types.BoolValue(false), access: memberSet, wantErrors: 1},
+	}
+
+	r := &spaceResource{}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			config := spaceResourceModel{ManageAccess: tc.manageAccess, MemberAccessList: tc.access, GroupAccessList: emptyGroups}
+			if got := spaceManagesAccess(config); got != tc.wantManaged {
+				t.Errorf("spaceManagesAccess() = %v, want %v", got, tc.wantManaged)
+			}
+			if errors := r.validateManageAccessConfig(context.Background(), config); len(errors) != tc.wantErrors {
+				t.Errorf("validateManageAccessConfig() returned %d errors (%v), want %d", len(errors), errors, tc.wantErrors)
+			}
+		})
+	}
+}
+
+func TestNewSpaceAccessEntries(t *testing.T) {
+	t.Parallel()
+
+	memberSet := func(userUUIDs ...types.String) types.Set {
+		members := []spaceMemberAccessBlockModel{}
+		for _, userUUID := range userUUIDs {
+			members = append(members, spaceMemberAccessBlockModel{UserUUID: userUUID, Email: types.StringNull(), SpaceRole: types.StringValue("viewer")})
+		}
+		set, diags := convertToMemberAccessSet(members)
+		if diags.HasError() {
+			t.Fatalf("failed to build member set: %v", diags)
+		}
+		return set
+	}
+
+	planned := memberSet(types.StringValue("user-1"), types.StringValue("user-2"), types.StringUnknown())
+	previous := memberSet(types.StringValue("user-1"))
+
+	entries := newSpaceAccessEntries(planned, previous, "access", "user_uuid")
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 new entry, got %v", entries)
+	}
+	entryPath, ok := entries["user-2"]
+	if !ok {
+		t.Fatalf("expected user-2 to be new, got %v", entries)
+	}
+	if !strings.HasPrefix(entryPath.String(), "access[Value(") {
+		t.Errorf("unexpected path: %s", entryPath)
+	}
+
+	// Every known entry is new when there is no previous state
+	if entries := newSpaceAccessEntries(planned, types.SetNull(previous.ElementType(context.Background())), "access", "user_uuid"); len(entries) != 2 {
+		t.Errorf("expected 2 new entries, got %v", entries)
+	}
+	if entries := newSpaceAccessEntries(types.SetUnknown(previous.ElementType(context.Background())), previous, "access", "user_uuid"); len(entries) != 0 {
+		t.Errorf("expected no entries for an unknown set, got %v", entries)
+	}
+}
+
 func boolPointer(v bool) *bool {
 	return &v
 }
 
+func TestPopulateEffectiveAccessSet(t *testing.T) {
+	t.Parallel()
+
+	yes, no := true, false
+	emptyString, parentSpace, editor := "", "parent_space", "editor"
+	members := []models.SpaceMemberAccess{
+		{UserUUID: "user-1", SpaceRole: models.SPACE_ADMIN_ROLE, HasDirectAccess: &yes, InheritedFrom: &emptyString, ProjectRole: &emptyString},
+		{UserUUID: "user-2", SpaceRole: models.SPACE_EDITOR_ROLE, HasDirectAccess: &no, InheritedFrom: &parentSpace, ProjectRole: &editor},
+	}
+
+	var diags diag.Diagnostics
+	set := populateEffectiveAccessSet(members, &diags)
+	if diags.HasError() {
+		t.Fatalf("populateEffectiveAccessSet() returned errors: %v", diags)
+	}
+
+	got := []spaceEffectiveAccessModel{}
+	diags.Append(set.ElementsAs(context.Background(), &got, false)...)
+	if diags.HasError() {
+		t.Fatalf("failed to read set: %v", diags)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 elements, got %d", len(got))
+	}
+	bySource := map[string]spaceEffectiveAccessModel{}
+	for _, access := range got {
+		bySource[access.Source.ValueString()] = access
+	}
+	if direct := bySource["direct"]; direct.UserUUID.ValueString() != "user-1" || !direct.ProjectRole.IsNull() {
+		t.Errorf("unexpected direct access: %+v", direct)
+	}
+	if inherited := bySource["parent_space"]; inherited.UserUUID.ValueString() != "user-2" || inherited.SpaceRole.ValueString() != "editor" || inherited.ProjectRole.ValueString() != "editor" {
+		t.Errorf("unexpected inherited access: %+v", inherited)
+	}
+
+	empty := populateEffectiveAccessSet(nil, &diags)
+	if empty.IsNull() || len(empty.Elements()) != 0 {
+		t.Errorf("expected an empty set, got %v", empty)
+	}
+}
+
+func TestSpaceAccessSettingsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	memberSet := func(userUUID string) types.Set {
+		set, diags := convertToMemberAccessSet([]spaceMemberAccessBlockModel{
+			{UserUUID: types.StringValue(userUUID), Email: types.StringNull(), SpaceRole: types.StringValue("viewer")},
+		})
+		if diags.HasError() {
+			t.Fatalf("failed to build member set: %v", diags)
+		}
+		return set
+	}
+	groupSet, diags := convertToGroupAccessSet(nil)
+	if diags.HasError() {
+		t.Fatalf("failed to build group set: %v", diags)
+	}
+	base := spaceResourceModel{
+		SpaceName:        types.StringValue("Space"),
+		ParentSpaceUUID:  types.StringNull(),
+		IsPrivate:        types.BoolValue(true),
+		MemberAccessList: memberSet("user-1"),
+		GroupAccessList:  groupSet,
+	}
+
+	renamed := base
+	renamed.SpaceName = types.StringValue("Renamed")
+	moved := base
+	moved.ParentSpaceUUID = types.StringValue("parent-uuid")
+	public := base
+	public.IsPrivate = types.BoolValue(false)
+	unknownPrivacy := base
+	unknownPrivacy.IsPrivate = types.BoolUnknown()
+	newMember := base
+	newMember.MemberAccessList = memberSet("user-2")
+
+	cases := []struct {
+		name string
+		plan spaceResourceModel
+		want bool
+	}{
+		{name: "rename only", plan: renamed, want: true},
+		{name: "moved", plan: moved, want: false},
+		{name: "made public", plan: public, want: false},
+		{name: "unknown privacy", plan: unknownPrivacy, want: false},
+		{name: "member changed", plan: newMember, want: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := spaceAccessSettingsUnchanged(tc.plan, base); got != tc.want {
+				t.Errorf("spaceAccessSettingsUnchanged() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestAccSpaceResource_simple(t *testing.T) {
 	if !isIntegrationTestMode() {
 		t.Skip("Skipping acceptance test for resource_lightdash_space")
@@ -116,6 +414,13 @@ func TestAccSpaceResource_simple(t *testing.T) {
 						"lightdash_space.create_space__test_public",
 						"name",
 					),
+					// data.lightdash_space.create_space__test_public_by_path
+					resource.TestCheckResourceAttrPair(
+						"data.lightdash_space.create_space__test_public_by_path",
+						"space_uuid",
+						"lightdash_space.create_space__test_public",
+						"space_uuid",
+					),
 
 					// lightdash_space.create_space__test_private
 					resource.TestCheckResourceAttrSet("lightdash_space.create_space__test_private", "space_uuid"),
@@ -389,6 +694,14 @@ func TestAccSpaceResource_access(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to get spaceAccessConfig: %v", err)
 	}
+	spaceAccessConfig030, err := ReadAccTestResource([]string{"resources", "lightdash_space", "space_access", "030_space_access_email.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get spaceAccessConfig: %v", err)
+	}
+	spaceAccessConfig040, err := ReadAccTestResource([]string{"resources", "lightdash_space", "space_access", "040_space_access_invalid_group.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get spaceAccessConfig: %v", err)
+	}
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -404,6 +717,7 @@ func TestAccSpaceResource_access(t *testing.T) {
 					resource.TestCheckResourceAttr("lightdash_space.space_access__test_space_2", "name", "Space 2 (Acceptance Test: space_access)"),
 					resource.TestCheckResourceAttr("lightdash_space.space_access__test_space_2", "is_private", "true"),
 					resource.TestCheckResourceAttr("lightdash_space.space_access__test_space_2", "group_access.#", "3"),
+					resource.TestCheckResourceAttrSet("lightdash_space.space_access__test_space_2", "effective_access.#"),
 				),
 			},
 			{
@@ -417,6 +731,21 @@ func TestAccSpaceResource_access(t *testing.T) {
 					resource.TestCheckResourceAttr("lightdash_space.space_access__test_space_2", "group_access.#", "0"),
 				),
 			},
+			{
+				Config: providerConfig + spaceAccessConfig030,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space.space_access__test_space_1", "access.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"lightdash_space.space_access__test_space_1", "access.*.user_uuid",
+						"data.lightdash_authenticated_user.test", "user_uuid",
+					),
+				),
+			},
+			{
+				Config:      providerConfig + spaceAccessConfig040,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid space group access"),
+			},
 		},
 	})
 }
@@ -515,3 +844,52 @@ func TestAccSpaceResource_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccSpaceResource_forceDestroy(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_space - force destroy")
+	}
+
+	// Get the provider config
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	forceDestroyConfig010, err := ReadAccTestResource([]string{"resources", "lightdash_space", "force_destroy", "010_force_destroy.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get forceDestroyConfig010: %v", err)
+	}
+	forceDestroyConfig020, err := ReadAccTestResource([]string{"resources", "lightdash_space", "force_destroy", "020_force_destroy.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get forceDestroyConfig020: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + forceDestroyConfig010,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space.force_destroy_root", "force_destroy", "relocate"),
+					resource.TestCheckResourceAttrPair(
+						"lightdash_space.force_destroy_root",
+						"force_destroy_fallback_space_uuid",
+						"lightdash_space.force_destroy_fallback",
+						"space_uuid",
+					),
+					resource.TestCheckResourceAttr("lightdash_space.force_destroy_child", "force_destroy", "delete"),
+				),
+			},
+			{
+				Config: providerConfig + forceDestroyConfig020,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space.force_destroy_root", "force_destroy", "delete"),
+					resource.TestCheckNoResourceAttr("lightdash_space.force_destroy_root", "force_destroy_fallback_space_uuid"),
+					resource.TestCheckNoResourceAttr("lightdash_space.force_destroy_child", "force_destroy"),
+				),
+			},
+		},
+	})
+}
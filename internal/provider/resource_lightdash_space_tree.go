@@ -0,0 +1,568 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// spaceTreeMaxDepth is the number of nesting levels of the spaces attribute.
+// Terraform schemas cannot be recursive, so each level is declared explicitly.
+const spaceTreeMaxDepth = 5
+
+var (
+	_ resource.Resource                   = &spaceTreeResource{}
+	_ resource.ResourceWithConfigure      = &spaceTreeResource{}
+	_ resource.ResourceWithValidateConfig = &spaceTreeResource{}
+)
+
+func NewSpaceTreeResource() resource.Resource {
+	return &spaceTreeResource{}
+}
+
+type spaceTreeResource struct {
+	client          *api.Client
+	spaceController *controllers.SpaceController
+}
+
+type spaceTreeResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectUUID        types.String `tfsdk:"project_uuid"`
+	ParentSpaceUUID    types.String `tfsdk:"parent_space_uuid"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	Spaces             types.List   `tfsdk:"spaces"`
+	SpaceUUIDs         types.Map    `tfsdk:"space_uuids"`
+}
+
+// spaceTreeMemberAccessModel maps the access of a node, which only references users by UUID
+type spaceTreeMemberAccessModel struct {
+	UserUUID  types.String `tfsdk:"user_uuid"`
+	SpaceRole types.String `tfsdk:"space_role"`
+}
+
+func (r *spaceTreeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_tree"
+}
+
+func (r *spaceTreeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_space_tree.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a nested hierarchy of Lightdash spaces",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/space_trees/<key>`, where the key is the one of the first top-level space.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space holding the top-level spaces of the tree. Leave empty to create them as root spaces.",
+				Optional:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, prevents deleting any space of the tree, whether by destroying the resource or by removing a node. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"spaces": spaceTreeNodesAttribute(1),
+			"space_uuids": schema.MapAttribute{
+				MarkdownDescription: "The UUIDs of the managed spaces, keyed by node key.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+// spaceTreeNodesAttribute declares the spaces at the given nesting level, including their children down to spaceTreeMaxDepth.
+func spaceTreeNodesAttribute(depth int) schema.ListNestedAttribute {
+	attributes := map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			MarkdownDescription: "A stable identifier of the node, unique across the tree. Defaults to `name`. Set it to rename a space or move it to another parent without recreating it.",
+			Optional:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the space.",
+			Required:            true,
+		},
+		"is_private": schema.BoolAttribute{
+			MarkdownDescription: "Whether the space is private (`true`) or public (`false`). Nested spaces inherit the visibility of their parent when unset.",
+			Optional:            true,
+		},
+		"access": schema.SetNestedAttribute{
+			MarkdownDescription: "Direct user access to the space.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user_uuid": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the Lightdash user.",
+						Required:            true,
+					},
+					"space_role": schema.StringAttribute{
+						MarkdownDescription: "The role of the user in the space: `admin`, `editor` or `viewer`.",
+						Required:            true,
+					},
+				},
+			},
+		},
+		"group_access": schema.SetNestedAttribute{
+			MarkdownDescription: "Group access to the space.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"group_uuid": schema.StringAttribute{
+						MarkdownDescription: "The UUID of the Lightdash group.",
+						Required:            true,
+					},
+					"space_role": schema.StringAttribute{
+						MarkdownDescription: "The role of the group in the space: `admin`, `editor` or `viewer`.",
+						Required:            true,
+					},
+				},
+			},
+		},
+	}
+	if depth < spaceTreeMaxDepth {
+		attributes["children"] = spaceTreeNodesAttribute(depth + 1)
+	}
+
+	description := "The top-level spaces of the tree."
+	required := true
+	if depth > 1 {
+		description = fmt.Sprintf("The nested spaces at level %d of the tree.", depth)
+		required = false
+	}
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description,
+		Required:            required,
+		Optional:            !required,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func (r *spaceTreeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+	r.spaceController = controllers.NewSpaceController(client)
+}
+
+func (r *spaceTreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config spaceTreeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || !spaceTreeListIsKnown(config.Spaces) {
+		return
+	}
+
+	nodes, diags := spaceTreeNodesFromList(ctx, config.Spaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(nodes) == 0 {
+		resp.Diagnostics.AddError("Invalid space tree", "spaces must contain at least one space")
+	}
+	for _, key := range duplicateSpaceTreeKeys(nodes) {
+		resp.Diagnostics.AddError(
+			"Invalid space tree",
+			fmt.Sprintf("The node key %q is used more than once. Set a unique key on spaces that share a name.", key),
+		)
+	}
+}
+
+func (r *spaceTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spaceTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := spaceTreeNodesFromList(ctx, plan.Spaces)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceUUIDs, err := r.spaceController.ApplySpaceTree(ctx, controllers.ApplySpaceTreeOptions{
+		ProjectUUID:        plan.ProjectUUID.ValueString(),
+		ParentSpaceUUID:    plan.ParentSpaceUUID.ValueStringPointer(),
+		Desired:            desired,
+		SpaceUUIDs:         map[string]string{},
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+	})
+	if len(spaceUUIDs) > 0 && len(desired) > 0 {
+		// Record the spaces created so far, so that a failed create can be cleaned up by Terraform
+		plan.ID = types.StringValue(getSpaceTreeResourceID(plan.ProjectUUID.ValueString(), desired[0].Key))
+		plan.SpaceUUIDs, diags = types.MapValueFrom(ctx, types.StringType, spaceUUIDs)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating space tree", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created space tree %s with %d spaces", plan.ID.ValueString(), len(spaceUUIDs)))
+}
+
+// Read drops nodes whose space is gone or was moved outside of Terraform from the state,
+// and records renamed spaces under their current name and changed visibility and access as they
+// are, so that the next plan puts them back.
+func (r *spaceTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state spaceTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceUUIDs := map[string]string{}
+	resp.Diagnostics.Append(state.SpaceUUIDs.ElementsAs(ctx, &spaceUUIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := r.spaceController.GetSpaceTreeLiveSpaces(ctx, state.ProjectUUID.ValueString(), spaceUUIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading space tree", err.Error())
+		return
+	}
+	if len(live) == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("No space of space tree %s exists anymore, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	for key := range spaceUUIDs {
+		if _, exists := live[key]; !exists {
+			delete(spaceUUIDs, key)
+		}
+	}
+
+	var diags diag.Diagnostics
+	state.Spaces, diags = refreshSpaceTreeList(ctx, state.Spaces, spaceUUIDs, live, state.ParentSpaceUUID.ValueStringPointer())
+	resp.Diagnostics.Append(diags...)
+	state.SpaceUUIDs, diags = types.MapValueFrom(ctx, types.StringType, spaceUUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *spaceTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state spaceTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := spaceTreeNodesFromList(ctx, plan.Spaces)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := spaceTreeNodesFromList(ctx, state.Spaces)
+	resp.Diagnostics.Append(diags...)
+	spaceUUIDs := map[string]string{}
+	resp.Diagnostics.Append(state.SpaceUUIDs.ElementsAs(ctx, &spaceUUIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceUUIDs, err := r.spaceController.ApplySpaceTree(ctx, controllers.ApplySpaceTreeOptions{
+		ProjectUUID:        plan.ProjectUUID.ValueString(),
+		ParentSpaceUUID:    plan.ParentSpaceUUID.ValueStringPointer(),
+		Desired:            desired,
+		Previous:           previous,
+		SpaceUUIDs:         spaceUUIDs,
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+	})
+	// Record the spaces as far as the update got; the next refresh drops nodes that were not applied
+	plan.SpaceUUIDs, diags = types.MapValueFrom(ctx, types.StringType, spaceUUIDs)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating space tree", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Updated space tree %s", plan.ID.ValueString()))
+}
+
+func (r *spaceTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spaceTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := spaceTreeNodesFromList(ctx, state.Spaces)
+	resp.Diagnostics.Append(diags...)
+	spaceUUIDs := map[string]string{}
+	resp.Diagnostics.Append(state.SpaceUUIDs.ElementsAs(ctx, &spaceUUIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.spaceController.DeleteSpaceTree(ctx, controllers.ApplySpaceTreeOptions{
+		ProjectUUID:        state.ProjectUUID.ValueString(),
+		ParentSpaceUUID:    state.ParentSpaceUUID.ValueStringPointer(),
+		Previous:           previous,
+		SpaceUUIDs:         spaceUUIDs,
+		DeletionProtection: state.DeletionProtection.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting space tree", err.Error())
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted space tree %s", state.ID.ValueString()))
+}
+
+// spaceTreeNodesFromList converts the spaces attribute into controller nodes.
+// The node key falls back to the name when unset.
+func spaceTreeNodesFromList(ctx context.Context, list types.List) ([]controllers.SpaceTreeNode, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nodes := []controllers.SpaceTreeNode{}
+	if list.IsNull() || list.IsUnknown() {
+		return nodes, diags
+	}
+
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			diags.AddError("Invalid space tree", fmt.Sprintf("unexpected element type %T", element))
+			return nodes, diags
+		}
+		attributes := object.Attributes()
+
+		node := controllers.SpaceTreeNode{
+			Key:       spaceTreeNodeKey(attributes),
+			Name:      attributes["name"].(types.String).ValueString(),
+			IsPrivate: attributes["is_private"].(types.Bool).ValueBoolPointer(),
+		}
+
+		memberAccess := []spaceTreeMemberAccessModel{}
+		if access := attributes["access"].(types.Set); !access.IsNull() && !access.IsUnknown() {
+			diags.Append(access.ElementsAs(ctx, &memberAccess, false)...)
+		}
+		node.MemberAccess = make([]models.SpaceAccessMember, 0, len(memberAccess))
+		for _, member := range memberAccess {
+			node.MemberAccess = append(node.MemberAccess, models.SpaceAccessMember{
+				UserUUID:  member.UserUUID.ValueString(),
+				SpaceRole: models.SpaceMemberRole(member.SpaceRole.ValueString()),
+			})
+		}
+
+		groupAccess := []spaceGroupAccessBlockModel{}
+		if access := attributes["group_access"].(types.Set); !access.IsNull() && !access.IsUnknown() {
+			diags.Append(access.ElementsAs(ctx, &groupAccess, false)...)
+		}
+		node.GroupAccess = convertToControllerGroupAccess(groupAccess)
+
+		if children, ok := attributes["children"].(types.List); ok {
+			childNodes, childDiags := spaceTreeNodesFromList(ctx, children)
+			diags.Append(childDiags...)
+			node.Children = childNodes
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, diags
+}
+
+func spaceTreeNodeKey(attributes map[string]attr.Value) string {
+	if key, ok := attributes["key"].(types.String); ok && !key.IsNull() && !key.IsUnknown() {
+		return key.ValueString()
+	}
+	return attributes["name"].(types.String).ValueString()
+}
+
+// spaceTreeListIsKnown reports whether all keys and names of the tree are known.
+func spaceTreeListIsKnown(list types.List) bool {
+	if list.IsUnknown() {
+		return false
+	}
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			return false
+		}
+		attributes := object.Attributes()
+		if attributes["key"].IsUnknown() || attributes["name"].IsUnknown() {
+			return false
+		}
+		if children, ok := attributes["children"].(types.List); ok && !spaceTreeListIsKnown(children) {
+			return false
+		}
+	}
+	return true
+}
+
+// duplicateSpaceTreeKeys returns the node keys used more than once, in tree order.
+func duplicateSpaceTreeKeys(nodes []controllers.SpaceTreeNode) []string {
+	seen := map[string]int{}
+	duplicates := []string{}
+	var walk func(nodes []controllers.SpaceTreeNode)
+	walk = func(nodes []controllers.SpaceTreeNode) {
+		for _, node := range nodes {
+			seen[node.Key]++
+			if seen[node.Key] == 2 {
+				duplicates = append(duplicates, node.Key)
+			}
+			walk(node.Children)
+		}
+	}
+	walk(nodes)
+	return duplicates
+}
+
+// refreshSpaceTreeList rebuilds the spaces attribute from the live spaces.
+// Nodes without a live space, or whose space sits under another parent, are dropped together
+// with their children; the names and access of the remaining nodes are taken from Lightdash,
+// and so is the visibility when the node sets it.
+func refreshSpaceTreeList(
+	ctx context.Context,
+	list types.List,
+	spaceUUIDs map[string]string,
+	live map[string]controllers.SpaceTreeLiveSpace,
+	parentSpaceUUID *string,
+) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() || list.IsUnknown() {
+		return list, diags
+	}
+
+	elements := []attr.Value{}
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		attributes := object.Attributes()
+		key := spaceTreeNodeKey(attributes)
+		spaceUUID, managed := spaceUUIDs[key]
+		liveSpace, exists := live[key]
+		if !managed || !exists || !models.EqualOptionalStrings(liveSpace.ParentSpaceUUID, parentSpaceUUID) {
+			continue
+		}
+
+		refreshed := map[string]attr.Value{}
+		for name, value := range attributes {
+			refreshed[name] = value
+		}
+		refreshed["name"] = types.StringValue(liveSpace.Name)
+		if liveSpace.Name != attributes["name"].(types.String).ValueString() && attributes["key"].IsNull() {
+			// Keep the node identifiable after the rename
+			refreshed["key"] = types.StringValue(key)
+		}
+		if !attributes["is_private"].IsNull() {
+			refreshed["is_private"] = types.BoolValue(!liveSpace.InheritParentPermissions)
+		}
+		var accessDiags diag.Diagnostics
+		refreshed["access"], refreshed["group_access"], accessDiags = refreshSpaceTreeAccess(ctx, attributes, liveSpace)
+		diags.Append(accessDiags...)
+		if children, ok := attributes["children"].(types.List); ok {
+			refreshedChildren, childDiags := refreshSpaceTreeList(ctx, children, spaceUUIDs, live, &spaceUUID)
+			diags.Append(childDiags...)
+			refreshed["children"] = refreshedChildren
+		}
+
+		refreshedObject, objectDiags := types.ObjectValue(object.AttributeTypes(ctx), refreshed)
+		diags.Append(objectDiags...)
+		elements = append(elements, refreshedObject)
+	}
+
+	refreshedList, listDiags := types.ListValue(list.ElementType(ctx), elements)
+	diags.Append(listDiags...)
+	return refreshedList, diags
+}
+
+// refreshSpaceTreeAccess returns the access and group_access attributes of a node from its live space.
+// An unset attribute stays unset while the space has no such access.
+func refreshSpaceTreeAccess(ctx context.Context, attributes map[string]attr.Value, live controllers.SpaceTreeLiveSpace) (types.Set, types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	access := attributes["access"].(types.Set)
+	if !access.IsNull() || len(live.MemberAccess) > 0 {
+		members := make([]spaceTreeMemberAccessModel, 0, len(live.MemberAccess))
+		for _, member := range live.MemberAccess {
+			members = append(members, spaceTreeMemberAccessModel{
+				UserUUID:  types.StringValue(member.UserUUID),
+				SpaceRole: types.StringValue(string(member.SpaceRole)),
+			})
+		}
+		var setDiags diag.Diagnostics
+		access, setDiags = types.SetValueFrom(ctx, access.ElementType(ctx), members)
+		diags.Append(setDiags...)
+	}
+
+	groupAccess := attributes["group_access"].(types.Set)
+	if !groupAccess.IsNull() || len(live.GroupAccess) > 0 {
+		groups := make([]spaceGroupAccessBlockModel, 0, len(live.GroupAccess))
+		for _, group := range live.GroupAccess {
+			groups = append(groups, spaceGroupAccessBlockModel{
+				GroupUUID: types.StringValue(group.GroupUUID),
+				SpaceRole: types.StringValue(string(group.SpaceRole)),
+			})
+		}
+		var setDiags diag.Diagnostics
+		groupAccess, setDiags = types.SetValueFrom(ctx, groupAccess.ElementType(ctx), groups)
+		diags.Append(setDiags...)
+	}
+	return access, groupAccess, diags
+}
+
+func getSpaceTreeResourceID(projectUUID, key string) string {
+	return fmt.Sprintf("projects/%s/space_trees/%s", projectUUID, key)
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/controllers"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/models"
+)
+
+// testSpaceTreeNode is a compact description of a node used to build the spaces attribute in tests.
+type testSpaceTreeNode struct {
+	key      *string
+	name     string
+	children []testSpaceTreeNode
+}
+
+func testSpaceTreeList(t *testing.T, depth int, nodes []testSpaceTreeNode) types.List {
+	t.Helper()
+	listType := spaceTreeNodesAttribute(depth).GetType().(types.ListType)
+	objectType := listType.ElemType.(types.ObjectType)
+
+	elements := []attr.Value{}
+	for _, node := range nodes {
+		attributes := map[string]attr.Value{
+			"key":          types.StringPointerValue(node.key),
+			"name":         types.StringValue(node.name),
+			"is_private":   types.BoolNull(),
+			"access":       types.SetNull(objectType.AttrTypes["access"].(types.SetType).ElemType),
+			"group_access": types.SetNull(objectType.AttrTypes["group_access"].(types.SetType).ElemType),
+		}
+		if _, ok := objectType.AttrTypes["children"]; ok {
+			if node.children == nil {
+				attributes["children"] = types.ListNull(objectType.AttrTypes["children"].(types.ListType).ElemType)
+			} else {
+				attributes["children"] = testSpaceTreeList(t, depth+1, node.children)
+			}
+		}
+		element, diags := types.ObjectValue(objectType.AttrTypes, attributes)
+		if diags.HasError() {
+			t.Fatalf("failed to build node: %v", diags)
+		}
+		elements = append(elements, element)
+	}
+	list, diags := types.ListValue(objectType, elements)
+	if diags.HasError() {
+		t.Fatalf("failed to build list: %v", diags)
+	}
+	return list
+}
+
+func spaceTreeKeys(nodes []controllers.SpaceTreeNode, parent string) []string {
+	keys := []string{}
+	for _, node := range nodes {
+		keys = append(keys, parent+"/"+node.Key+"("+node.Name+")")
+		keys = append(keys, spaceTreeKeys(node.Children, parent+"/"+node.Key)...)
+	}
+	return keys
+}
+
+func TestSpaceTreeNodesFromList(t *testing.T) {
+	t.Parallel()
+
+	key := "sales"
+	list := testSpaceTreeList(t, 1, []testSpaceTreeNode{
+		{name: "Marketing", children: []testSpaceTreeNode{
+			{name: "Campaigns"},
+		}},
+		{key: &key, name: "Sales & Revenue"},
+	})
+
+	nodes, diags := spaceTreeNodesFromList(context.Background(), list)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	expected := []string{"/Marketing(Marketing)", "/Marketing/Campaigns(Campaigns)", "/sales(Sales & Revenue)"}
+	if got := spaceTreeKeys(nodes, ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if duplicates := duplicateSpaceTreeKeys(nodes); len(duplicates) != 0 {
+		t.Errorf("expected no duplicate keys, got %v", duplicates)
+	}
+
+	duplicated := testSpaceTreeList(t, 1, []testSpaceTreeNode{
+		{name: "Reports", children: []testSpaceTreeNode{{name: "Archive"}}},
+		{name: "Finance", children: []testSpaceTreeNode{{name: "Archive"}}},
+	})
+	nodes, _ = spaceTreeNodesFromList(context.Background(), duplicated)
+	if duplicates := duplicateSpaceTreeKeys(nodes); !reflect.DeepEqual(duplicates, []string{"Archive"}) {
+		t.Errorf("expected duplicate key Archive, got %v", duplicates)
+	}
+}
+
+func TestRefreshSpaceTreeList(t *testing.T) {
+	t.Parallel()
+
+	list := testSpaceTreeList(t, 1, []testSpaceTreeNode{
+		{name: "Marketing", children: []testSpaceTreeNode{
+			{name: "Campaigns"},
+			{name: "Moved"},
+			{name: "Deleted"},
+		}},
+	})
+	spaceUUIDs := map[string]string{"Marketing": "uuid-marketing", "Campaigns": "uuid-campaigns", "Moved": "uuid-moved", "Deleted": "uuid-deleted"}
+	marketing := "uuid-marketing"
+	elsewhere := "uuid-elsewhere"
+	// The group was granted access in Lightdash
+	group := models.SpaceAccessGroup{GroupUUID: "group-1", SpaceRole: models.SPACE_VIEWER_ROLE}
+	live := map[string]controllers.SpaceTreeLiveSpace{
+		"Marketing": {Name: "Marketing"},
+		"Campaigns": {Name: "Campaigns 2026", ParentSpaceUUID: &marketing, GroupAccess: []models.SpaceAccessGroup{group}},
+		"Moved":     {Name: "Moved", ParentSpaceUUID: &elsewhere},
+	}
+
+	refreshed, diags := refreshSpaceTreeList(context.Background(), list, spaceUUIDs, live, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	nodes, _ := spaceTreeNodesFromList(context.Background(), refreshed)
+	// The renamed node keeps its key, the moved and deleted nodes are dropped
+	expected := []string{"/Marketing(Marketing)", "/Marketing/Campaigns(Campaigns 2026)"}
+	if got := spaceTreeKeys(nodes, ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := nodes[0].Children[0].GroupAccess; !reflect.DeepEqual(got, []models.SpaceAccessGroup{group}) {
+		t.Errorf("expected the group access from Lightdash, got %v", got)
+	}
+	if got := nodes[0].MemberAccess; len(got) != 0 {
+		t.Errorf("expected no member access, got %v", got)
+	}
+}
+
+func TestAccSpaceTreeResource(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_space_tree")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_tree", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_space_tree", "lifecycle", "020_move_and_rename.tf"})
+	if err != nil {
+		t.Fatalf("Failed to read acceptance test resource: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_space_tree.test", "id"),
+					resource.TestCheckResourceAttr("lightdash_space_tree.test", "space_uuids.%", "4"),
+					resource.TestCheckResourceAttrSet("lightdash_space_tree.test", "space_uuids.campaigns"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_space_tree.test", "space_uuids.%", "3"),
+					resource.TestCheckResourceAttr("lightdash_space_tree.test", "spaces.1.children.0.name", "Campaigns (renamed)"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,392 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+	"github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/services"
+)
+
+var (
+	_ resource.Resource                   = &sqlChartResource{}
+	_ resource.ResourceWithConfigure      = &sqlChartResource{}
+	_ resource.ResourceWithImportState    = &sqlChartResource{}
+	_ resource.ResourceWithValidateConfig = &sqlChartResource{}
+)
+
+// The default row limit of the Lightdash SQL runner.
+const defaultSQLChartLimit = 500
+
+func NewSQLChartResource() resource.Resource {
+	return &sqlChartResource{}
+}
+
+type sqlChartResource struct {
+	client *api.Client
+}
+
+type sqlChartResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ProjectUUID      types.String `tfsdk:"project_uuid"`
+	SpaceUUID        types.String `tfsdk:"space_uuid"`
+	SavedSQLUUID     types.String `tfsdk:"saved_sql_uuid"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	SQL              types.String `tfsdk:"sql"`
+	Limit            types.Int64  `tfsdk:"limit"`
+	Config           types.String `tfsdk:"config"`
+	ChartKind        types.String `tfsdk:"chart_kind"`
+	Slug             types.String `tfsdk:"slug"`
+	DeleteProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+func (r *sqlChartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sql_chart"
+}
+
+func (r *sqlChartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	markdownDescription, err := readMarkdownDescription(ctx, "internal/provider/docs/resources/resource_lightdash_sql_chart.md")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read markdown description",
+			fmt.Sprintf("Unable to read schema markdown description file: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: markdownDescription,
+		Description:         "Manages a Lightdash SQL chart",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource identifier. It is computed as `projects/<project_uuid>/sql_charts/<saved_sql_uuid>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the Lightdash project the SQL chart belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the space containing the SQL chart. Changing it moves the chart to another space.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"saved_sql_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the SQL chart assigned by Lightdash.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the SQL chart.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the SQL chart.",
+				Optional:            true,
+			},
+			"sql": schema.StringAttribute{
+				MarkdownDescription: "The SQL query. Leading and trailing whitespace is ignored when comparing with Lightdash.",
+				Required:            true,
+				Validators: []validator.String{
+					ValidateNonEmptyString{},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of rows returned by the query. Defaults to `500`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultSQLChartLimit),
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The visualization configuration as a JSON document, typically built with `jsonencode`. Its `type` field sets the chart kind, such as `table`, `bar`, `line` or `pie`.",
+				Required:            true,
+			},
+			"chart_kind": schema.StringAttribute{
+				MarkdownDescription: "The kind of chart derived from `config` by Lightdash.",
+				Computed:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The URL slug of the SQL chart.",
+				Computed:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "When set to `true`, prevents the destruction of the SQL chart resource by Terraform.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *sqlChartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *sqlChartResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sqlChartResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Config.IsNull() && !config.Config.IsUnknown() {
+		if err := validateSQLChartConfig(config.Config.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid SQL chart config", err.Error())
+		}
+	}
+	if !config.Limit.IsNull() && !config.Limit.IsUnknown() && config.Limit.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid SQL chart limit", fmt.Sprintf("limit must be positive, got %d", config.Limit.ValueInt64()))
+	}
+}
+
+// validateSQLChartConfig checks that the config is a JSON object with a chart type.
+func validateSQLChartConfig(config string) error {
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(config), &parsed); err != nil {
+		return fmt.Errorf("config must be a JSON object: %w", err)
+	}
+	chartType, ok := parsed["type"].(string)
+	if !ok || chartType == "" {
+		return fmt.Errorf("config must have a non-empty \"type\" field")
+	}
+	return nil
+}
+
+func (r *sqlChartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sqlChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSQLChartService(r.client)
+	chart, err := service.CreateSQLChart(ctx, plan.ProjectUUID.ValueString(), apiv1.CreateSQLChartV1Request{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		SQL:         plan.SQL.ValueString(),
+		Limit:       plan.Limit.ValueInt64(),
+		Config:      json.RawMessage(plan.Config.ValueString()),
+		SpaceUUID:   plan.SpaceUUID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SQL chart", err.Error())
+		return
+	}
+
+	plan.SavedSQLUUID = types.StringValue(chart.SavedSQLUUID)
+	plan.ID = types.StringValue(getSQLChartResourceID(plan.ProjectUUID.ValueString(), chart.SavedSQLUUID))
+	setSQLChartResourceFromChart(&plan, *chart)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sqlChartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sqlChartResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := services.NewSQLChartService(r.client)
+	chart, err := service.GetSQLChart(ctx, state.ProjectUUID.ValueString(), state.SavedSQLUUID.ValueString())
+	if err != nil {
+		if errors.Is(err, services.ErrSQLChartNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("SQL chart %s not found during Read, removing from state", state.SavedSQLUUID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading SQL chart", err.Error())
+		return
+	}
+
+	setSQLChartResourceFromChart(&state, *chart)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sqlChartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state sqlChartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only changes to the query or the visualization create a new chart version.
+	request := apiv1.UpdateSQLChartV1Request{}
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.SpaceUUID.Equal(state.SpaceUUID) {
+		request.UnversionedData = &apiv1.UpdateSQLChartUnversionedDataV1{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueStringPointer(),
+			SpaceUUID:   plan.SpaceUUID.ValueString(),
+		}
+	}
+	if !plan.SQL.Equal(state.SQL) || !plan.Limit.Equal(state.Limit) || !plan.Config.Equal(state.Config) {
+		request.VersionedData = &apiv1.UpdateSQLChartVersionedDataV1{
+			SQL:    plan.SQL.ValueString(),
+			Limit:  plan.Limit.ValueInt64(),
+			Config: json.RawMessage(plan.Config.ValueString()),
+		}
+	}
+
+	service := services.NewSQLChartService(r.client)
+	var chart *apiv1.SQLChartV1
+	var err error
+	if request.UnversionedData == nil && request.VersionedData == nil {
+		chart, err = service.GetSQLChart(ctx, state.ProjectUUID.ValueString(), state.SavedSQLUUID.ValueString())
+	} else {
+		chart, err = service.UpdateSQLChart(ctx, state.ProjectUUID.ValueString(), state.SavedSQLUUID.ValueString(), request)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating SQL chart", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.SavedSQLUUID = state.SavedSQLUUID
+	setSQLChartResourceFromChart(&plan, *chart)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sqlChartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sqlChartResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting SQL chart %s", state.SavedSQLUUID.ValueString()))
+
+	if state.DeleteProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection Enabled",
+			"Cannot delete SQL chart because deletion_protection is set to true. Set deletion_protection to false to allow deletion.",
+		)
+		return
+	}
+
+	service := services.NewSQLChartService(r.client)
+	if err := service.DeleteSQLChart(ctx, state.ProjectUUID.ValueString(), state.SavedSQLUUID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting SQL chart", err.Error())
+		return
+	}
+}
+
+func (r *sqlChartResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	extracted, err := extractSQLChartResourceID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error extracting resource ID", err.Error())
+		return
+	}
+	projectUUID := extracted[0]
+	savedSQLUUID := extracted[1]
+
+	service := services.NewSQLChartService(r.client)
+	chart, err := service.GetSQLChart(ctx, projectUUID, savedSQLUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SQL chart for import", err.Error())
+		return
+	}
+
+	state := sqlChartResourceModel{
+		ID:               types.StringValue(req.ID),
+		ProjectUUID:      types.StringValue(projectUUID),
+		SavedSQLUUID:     types.StringValue(chart.SavedSQLUUID),
+		Description:      types.StringNull(),
+		SQL:              types.StringNull(),
+		Config:           types.StringNull(),
+		DeleteProtection: types.BoolValue(true),
+	}
+	setSQLChartResourceFromChart(&state, *chart)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// setSQLChartResourceFromChart updates the model from the API response.
+// The configured SQL and config are kept when they are semantically equal to the response,
+// so that formatting differences do not produce a diff.
+func setSQLChartResourceFromChart(model *sqlChartResourceModel, chart apiv1.SQLChartV1) {
+	model.SpaceUUID = types.StringValue(chart.Space.UUID)
+	model.Name = types.StringValue(chart.Name)
+	model.Description = nonEmptyStringValue(chart.Description)
+	model.Limit = types.Int64Value(chart.Limit)
+	model.ChartKind = types.StringValue(chart.ChartKind)
+	model.Slug = types.StringValue(chart.Slug)
+
+	if model.SQL.IsNull() || model.SQL.IsUnknown() || strings.TrimSpace(model.SQL.ValueString()) != strings.TrimSpace(chart.SQL) {
+		model.SQL = types.StringValue(chart.SQL)
+	}
+
+	apiConfig := string(chart.Config)
+	if model.Config.IsNull() || model.Config.IsUnknown() {
+		model.Config = types.StringValue(apiConfig)
+	} else if equivalent, err := jsonStringsEquivalent(model.Config.ValueString(), apiConfig); err != nil || !equivalent {
+		model.Config = types.StringValue(apiConfig)
+	}
+}
+
+func getSQLChartResourceID(projectUUID string, savedSQLUUID string) string {
+	return fmt.Sprintf("projects/%s/sql_charts/%s", projectUUID, savedSQLUUID)
+}
+
+func extractSQLChartResourceID(input string) ([]string, error) {
+	pattern := `^projects/([^/]+)/sql_charts/([^/]+)$`
+	groups, err := extractStrings(input, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract resource ID: %w", err)
+	}
+	return []string{groups[0], groups[1]}, nil
+}
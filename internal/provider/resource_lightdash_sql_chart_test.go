@@ -0,0 +1,216 @@
+// Copyright 2023 Ubie, inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	apiv1 "github.com/ubie-oss/terraform-provider-lightdash/internal/lightdash/api/v1"
+)
+
+func TestExtractSQLChartResourceID(t *testing.T) {
+	t.Parallel()
+
+	got, err := extractSQLChartResourceID("projects/project-uuid/sql_charts/saved-sql-uuid")
+	if err != nil {
+		t.Fatalf("extractSQLChartResourceID: %v", err)
+	}
+	if got[0] != "project-uuid" {
+		t.Errorf("project UUID: got %q", got[0])
+	}
+	if got[1] != "saved-sql-uuid" {
+		t.Errorf("saved SQL UUID: got %q", got[1])
+	}
+
+	if _, err := extractSQLChartResourceID("saved-sql-uuid"); err == nil {
+		t.Fatal("expected error for invalid ID")
+	}
+}
+
+func TestGetSQLChartResourceID(t *testing.T) {
+	t.Parallel()
+
+	got := getSQLChartResourceID("project-uuid", "saved-sql-uuid")
+	want := "projects/project-uuid/sql_charts/saved-sql-uuid"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateSQLChartConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		config  string
+		wantErr bool
+	}{
+		{config: `{"type":"table","columns":{}}`},
+		{config: `{"columns":{}}`, wantErr: true},
+		{config: `["table"]`, wantErr: true},
+		{config: `not json`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := validateSQLChartConfig(test.config); (err != nil) != test.wantErr {
+			t.Errorf("validateSQLChartConfig(%s) error = %v, wantErr %v", test.config, err, test.wantErr)
+		}
+	}
+}
+
+func TestSetSQLChartResourceFromChart(t *testing.T) {
+	t.Parallel()
+
+	chart := apiv1.SQLChartV1{
+		SavedSQLUUID: "saved-sql-uuid",
+		Name:         "Weekly orders",
+		SQL:          "select 1 as value",
+		Limit:        500,
+		Config:       json.RawMessage(`{"type":"table","columns":{}}`),
+		ChartKind:    "table",
+		Space:        apiv1.SQLChartSpaceV1{UUID: "space-uuid"},
+	}
+
+	// Formatting differences keep the configured values.
+	model := sqlChartResourceModel{
+		SQL:    types.StringValue("select 1 as value\n"),
+		Config: types.StringValue(`{"columns": {}, "type": "table"}`),
+	}
+	setSQLChartResourceFromChart(&model, chart)
+	if model.SQL.ValueString() != "select 1 as value\n" {
+		t.Errorf("expected configured SQL to be kept, got %q", model.SQL.ValueString())
+	}
+	if model.Config.ValueString() != `{"columns": {}, "type": "table"}` {
+		t.Errorf("expected configured config to be kept, got %q", model.Config.ValueString())
+	}
+	if !model.Description.IsNull() {
+		t.Errorf("expected description to be null, got %q", model.Description.ValueString())
+	}
+
+	// Changes made in Lightdash are reflected as drift.
+	model = sqlChartResourceModel{
+		SQL:    types.StringValue("select 2 as value"),
+		Config: types.StringValue(`{"type":"bar"}`),
+	}
+	setSQLChartResourceFromChart(&model, chart)
+	if model.SQL.ValueString() != chart.SQL {
+		t.Errorf("expected SQL from Lightdash, got %q", model.SQL.ValueString())
+	}
+	if model.Config.ValueString() != string(chart.Config) {
+		t.Errorf("expected config from Lightdash, got %q", model.Config.ValueString())
+	}
+}
+
+func TestAccSQLChartResource_lifecycle(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_sql_chart")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	createConfig, err := ReadAccTestResource([]string{"resources", "lightdash_sql_chart", "lifecycle", "010_create.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get create config: %v", err)
+	}
+	updateConfig, err := ReadAccTestResource([]string{"resources", "lightdash_sql_chart", "lifecycle", "020_update.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get update config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + createConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_sql_chart.test", "name", "SQL Chart (Acceptance Test: sql chart lifecycle)"),
+					resource.TestCheckResourceAttrSet("lightdash_sql_chart.test", "saved_sql_uuid"),
+					resource.TestCheckResourceAttr("lightdash_sql_chart.test", "limit", "500"),
+					resource.TestCheckResourceAttr("lightdash_sql_chart.test", "chart_kind", "table"),
+				),
+			},
+			{
+				Config: providerConfig + updateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("lightdash_sql_chart.test", "name", "SQL Chart (Acceptance Test: sql chart lifecycle updated)"),
+					resource.TestCheckResourceAttr("lightdash_sql_chart.test", "sql", "select 2 as value\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQLChartResource_import(t *testing.T) {
+	if !isIntegrationTestMode() {
+		t.Skip("Skipping acceptance test for resource_lightdash_sql_chart")
+	}
+
+	providerConfig, err := getProviderConfig()
+	if err != nil {
+		t.Fatalf("Failed to get providerConfig: %v", err)
+	}
+
+	importConfig, err := ReadAccTestResource([]string{"resources", "lightdash_sql_chart", "import", "010_import.tf"})
+	if err != nil {
+		t.Fatalf("Failed to get import config: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + importConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lightdash_sql_chart.test", "saved_sql_uuid"),
+				),
+			},
+			{
+				Config:            providerConfig + importConfig,
+				ResourceName:      "lightdash_sql_chart.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"deletion_protection",
+					"sql",
+					"config",
+				},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					res, ok := state.RootModule().Resources["lightdash_sql_chart.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state for import")
+					}
+					projectUUID, ok := res.Primary.Attributes["project_uuid"]
+					if !ok || projectUUID == "" {
+						return "", fmt.Errorf("project_uuid attribute not present in state")
+					}
+					savedSQLUUID, ok := res.Primary.Attributes["saved_sql_uuid"]
+					if !ok || savedSQLUUID == "" {
+						return "", fmt.Errorf("saved_sql_uuid attribute not present in state")
+					}
+					return getSQLChartResourceID(projectUUID, savedSQLUUID), nil
+				},
+			},
+		},
+	})
+}
@@ -17,10 +17,12 @@ package provider
 import (
 	"context"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"runtime"
 	"sort"
@@ -266,3 +268,16 @@ func stringSliceToStringList(ctx context.Context, values []string) (types.List,
 	}
 	return types.ListValueFrom(ctx, types.StringType, elems)
 }
+
+// jsonStringsEquivalent reports whether two JSON documents are semantically equal,
+// ignoring whitespace and the order of object keys.
+func jsonStringsEquivalent(a, b string) (bool, error) {
+	var aValue, bValue any
+	if err := json.Unmarshal([]byte(a), &aValue); err != nil {
+		return false, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if err := json.Unmarshal([]byte(b), &bValue); err != nil {
+		return false, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return reflect.DeepEqual(aValue, bValue), nil
+}
@@ -197,3 +197,28 @@ func TestReadMarkdownDescriptionEmbedded(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONStringsEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+		wantErr  bool
+	}{
+		{a: `{"type":"table","display":{}}`, b: "{\n  \"display\": {},\n  \"type\": \"table\"\n}", expected: true},
+		{a: `{"limit":1}`, b: `{"limit":1.0}`, expected: true},
+		{a: `{"type":"table"}`, b: `{"type":"bar"}`, expected: false},
+		{a: `[1,2]`, b: `[2,1]`, expected: false},
+		{a: `{`, b: `{}`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := jsonStringsEquivalent(test.a, test.b)
+		if (err != nil) != test.wantErr {
+			t.Errorf("jsonStringsEquivalent(%s, %s) error = %v, wantErr %v", test.a, test.b, err, test.wantErr)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("jsonStringsEquivalent(%s, %s) = %v, want %v", test.a, test.b, got, test.expected)
+		}
+	}
+}